@@ -0,0 +1,168 @@
+package moedinha
+
+// Calculation chains operations over a Currency, recording the first error and skipping all the following
+// operations, so chained expressions need a single error check at the end. For example:
+//
+//	result, err := moedinha.Calc(price).Mul(quantity).Add(shipping).Round(2, moedinha.HalfEven).Result()
+//
+// The operations follow the checked variants (see AddChecked), and don't allocate memory unless an error happens.
+// Calculations only work on Currency, and the Fixed numbers of other precisions should be converted first (see
+// Convert).
+type Calculation struct {
+	value Currency
+	err   error
+}
+
+// Calc starts a calculation from c.
+func Calc(c Currency) Calculation {
+	return Calculation{value: c}
+}
+
+// CalcFromString starts a calculation from the Currency represented by str.
+// The parsing error, if any, is recorded as the calculation error.
+func CalcFromString(str string) Calculation {
+	c, err := NewFromString(str)
+
+	return Calculation{value: c, err: err}
+}
+
+// Result returns the calculation result, or the first error that happened during the calculation.
+func (c Calculation) Result() (Currency, error) {
+	if c.err != nil {
+		return Currency{}, c.err
+	}
+
+	return c.value, nil
+}
+
+// Err returns the first error that happened during the calculation, if any.
+func (c Calculation) Err() error {
+	return c.err
+}
+
+// Add adds v to the calculation. See AddChecked.
+func (c Calculation) Add(v Currency) Calculation {
+	if c.err != nil {
+		return c
+	}
+
+	c.value, c.err = c.value.AddChecked(v)
+
+	return c
+}
+
+// Sub subtracts v from the calculation. See SubChecked.
+func (c Calculation) Sub(v Currency) Calculation {
+	if c.err != nil {
+		return c
+	}
+
+	c.value, c.err = c.value.SubChecked(v)
+
+	return c
+}
+
+// Mul multiplies the calculation by v. See MulChecked.
+func (c Calculation) Mul(v Currency) Calculation {
+	if c.err != nil {
+		return c
+	}
+
+	c.value, c.err = c.value.MulChecked(v)
+
+	return c
+}
+
+// MulRound multiplies the calculation by v, rounding the product using the given rounding mode.
+// See MulRoundChecked.
+func (c Calculation) MulRound(v Currency, mode RoundingMode) Calculation {
+	if c.err != nil {
+		return c
+	}
+
+	c.value, c.err = c.value.MulRoundChecked(v, mode)
+
+	return c
+}
+
+// MulAdd multiplies the calculation by v and adds a, rounding the result a single time. See MulAddChecked.
+func (c Calculation) MulAdd(v, a Currency) Calculation {
+	if c.err != nil {
+		return c
+	}
+
+	c.value, c.err = c.value.MulAddChecked(v, a)
+
+	return c
+}
+
+// Div divides the calculation by v. See DivChecked.
+func (c Calculation) Div(v Currency) Calculation {
+	if c.err != nil {
+		return c
+	}
+
+	c.value, c.err = c.value.DivChecked(v)
+
+	return c
+}
+
+// DivRound divides the calculation by v, rounding the quotient to the given decimal places using the given
+// rounding mode. See DivRoundChecked.
+func (c Calculation) DivRound(v Currency, places int, mode RoundingMode) Calculation {
+	if c.err != nil {
+		return c
+	}
+
+	c.value, c.err = c.value.DivRoundChecked(v, places, mode)
+
+	return c
+}
+
+// MulDiv multiplies the calculation by v and divides it by d, keeping the double precision product.
+// See MulDivChecked.
+func (c Calculation) MulDiv(v, d Currency) Calculation {
+	if c.err != nil {
+		return c
+	}
+
+	c.value, c.err = c.value.MulDivChecked(v, d)
+
+	return c
+}
+
+// Round rounds the calculation to the given decimal places using the given rounding mode. See RoundChecked.
+func (c Calculation) Round(places int, mode RoundingMode) Calculation {
+	if c.err != nil {
+		return c
+	}
+
+	c.value, c.err = c.value.RoundChecked(places, mode)
+
+	return c
+}
+
+// Pow raises the calculation to the n-th power. See Pow.
+func (c Calculation) Pow(n int) Calculation {
+	if c.err != nil {
+		return c
+	}
+
+	c.value, c.err = c.value.Pow(n)
+
+	return c
+}
+
+// Neg negates the calculation.
+func (c Calculation) Neg() Calculation {
+	c.value = c.value.Neg()
+
+	return c
+}
+
+// Abs replaces the calculation by its absolute value.
+func (c Calculation) Abs() Calculation {
+	c.value = c.value.Abs()
+
+	return c
+}
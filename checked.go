@@ -0,0 +1,128 @@
+package moedinha
+
+import "fmt"
+
+// AddChecked returns c + v, like Add, but returning an error wrapping ErrOverflow instead of panicking.
+func (c Fixed[P]) AddChecked(v Fixed[P]) (Fixed[P], error) {
+	result, overflow := c.add(v)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("calculating %s + %s: %w", c.String(), v.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// SubChecked returns c - v, like Sub, but returning an error wrapping ErrOverflow instead of panicking.
+func (c Fixed[P]) SubChecked(v Fixed[P]) (Fixed[P], error) {
+	result, overflow := c.sub(v)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("calculating %s - %s: %w", c.String(), v.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// MulChecked returns c * v, like Mul, but returning an error wrapping ErrOverflow instead of panicking.
+func (c Fixed[P]) MulChecked(v Fixed[P]) (Fixed[P], error) {
+	return c.MulRoundChecked(v, DefaultRoundingMode)
+}
+
+// MulRoundChecked returns c * v, like MulRound, but returning an error wrapping ErrOverflow instead of
+// panicking.
+func (c Fixed[P]) MulRoundChecked(v Fixed[P], mode RoundingMode) (Fixed[P], error) {
+	result, _, overflow := c.mulRound(v, mode)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("calculating %s * %s: %w", c.String(), v.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// MulAddChecked returns c * v + a, like MulAdd, but returning an error wrapping ErrOverflow instead of
+// panicking.
+func (c Fixed[P]) MulAddChecked(v, a Fixed[P]) (Fixed[P], error) {
+	result, _, overflow := c.mulAddRound(v, a, DefaultRoundingMode)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("calculating %s * %s + %s: %w", c.String(), v.String(), a.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// DivChecked returns c / v, like Div, but returning an error wrapping ErrDivisionByZero or ErrOverflow
+// instead of panicking.
+func (c Fixed[P]) DivChecked(v Fixed[P]) (Fixed[P], error) {
+	return c.DivRoundChecked(v, currencyDecimalDigits, DefaultRoundingMode)
+}
+
+// DivRoundChecked returns c / v, like DivRound, but returning an error wrapping ErrDivisionByZero or
+// ErrOverflow instead of panicking.
+func (c Fixed[P]) DivRoundChecked(v Fixed[P], places int, mode RoundingMode) (Fixed[P], error) {
+	if v.t.isZero() {
+		return Fixed[P]{}, fmt.Errorf("calculating %s / %s: %w", c.String(), v.String(), ErrDivisionByZero)
+	}
+
+	result, _, overflow := c.divRound(v, places, mode)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("calculating %s / %s: %w", c.String(), v.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// MulDivChecked returns c * v / d, like MulDiv, but returning an error wrapping ErrDivisionByZero or
+// ErrOverflow instead of panicking.
+func (c Fixed[P]) MulDivChecked(v, d Fixed[P]) (Fixed[P], error) {
+	return c.MulDivRoundChecked(v, d, currencyDecimalDigits, DefaultRoundingMode)
+}
+
+// MulDivRoundChecked returns c * v / d, like MulDivRound, but returning an error wrapping ErrDivisionByZero
+// or ErrOverflow instead of panicking.
+func (c Fixed[P]) MulDivRoundChecked(v, d Fixed[P], places int, mode RoundingMode) (Fixed[P], error) {
+	if d.t.isZero() {
+		return Fixed[P]{}, fmt.Errorf("calculating %s * %s / %s: %w", c.String(), v.String(), d.String(), ErrDivisionByZero)
+	}
+
+	result, overflow := c.mulDivRound(v, d, places, mode)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("calculating %s * %s / %s: %w", c.String(), v.String(), d.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// QuoRemChecked returns the integer quotient and the remainder of c / v, like QuoRem, but returning an error
+// wrapping ErrDivisionByZero or ErrOverflow instead of panicking.
+func (c Fixed[P]) QuoRemChecked(v Fixed[P]) (Fixed[P], Fixed[P], error) {
+	if v.t.isZero() {
+		return Fixed[P]{}, Fixed[P]{}, fmt.Errorf("calculating %s / %s: %w", c.String(), v.String(), ErrDivisionByZero)
+	}
+
+	q, r, overflow := c.quoRem(v)
+	if overflow {
+		return Fixed[P]{}, Fixed[P]{}, fmt.Errorf("calculating %s / %s: %w", c.String(), v.String(), ErrOverflow)
+	}
+
+	return q, r, nil
+}
+
+// ModChecked returns the remainder of c / v, like Mod, but returning an error wrapping ErrDivisionByZero
+// instead of panicking.
+func (c Fixed[P]) ModChecked(v Fixed[P]) (Fixed[P], error) {
+	if v.t.isZero() {
+		return Fixed[P]{}, fmt.Errorf("calculating %s %% %s: %w", c.String(), v.String(), ErrDivisionByZero)
+	}
+
+	return c.Mod(v), nil
+}
+
+// RoundChecked returns c rounded to the given decimal places, like Round, but returning an error wrapping
+// ErrOverflow instead of panicking.
+func (c Fixed[P]) RoundChecked(places int, mode RoundingMode) (Fixed[P], error) {
+	result, overflow := c.round(places, mode)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("rounding %s: %w", c.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,294 @@
+// Command moedinhagen generates a self-contained copy of the moedinha package specialized to a given amount of
+// uint64 and decimal digits, as an alternative to editing the settings.go file of a vendored copy. Several
+// precisions can be generated side by side, e.g. with go:generate directives:
+//
+//	//go:generate go run github.com/mqzabin/moedinha/cmd/moedinhagen -uints 2 -decimals 2 -package money -type Amount -out money
+//
+// The generated package has the same API of moedinha, with the Currency type renamed to the given type name. The
+// tests and fuzz targets are generated as well, unless the -tests flag is false, and depend on the
+// github.com/mqzabin/fuzzdecimal and github.com/shopspring/decimal modules.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+const (
+	// importPath is the import path of the package used as the source of the generated packages.
+	importPath = "github.com/mqzabin/moedinha"
+	// sourceTypeName is the name of the type renamed to the -type flag value.
+	sourceTypeName = "Currency"
+	// settingsFile is the source file with the constants replaced by the -uints and -decimals flag values.
+	settingsFile = "settings.go"
+	// digitsPerUint is the amount of digits stored in each uint64.
+	digitsPerUint = 18
+	// maxUints is the greatest amount of uint64 supported by the multiplication.
+	maxUints = 17
+	// header is written on top of every generated file.
+	header = "// Code generated by moedinhagen. DO NOT EDIT.\n\n"
+)
+
+// sourceTypeRegexp matches the Currency type name in the comments.
+var sourceTypeRegexp = regexp.MustCompile(`\b` + sourceTypeName + `\b`)
+
+// skippedFiles are the source files that aren't generated, like the tests depending on the testdata directory.
+var skippedFiles = map[string]bool{
+	"dectest_test.go": true,
+}
+
+// config is the generated package configuration.
+type config struct {
+	// src is the directory of the source package. An empty directory means the moedinha module directory.
+	src string
+	// out is the directory where the generated package is written.
+	out string
+	// pkg is the generated package name.
+	pkg string
+	// typeName is the name of the Currency type in the generated package.
+	typeName string
+	// uints is the amount of uint64 used to represent the numbers.
+	uints int
+	// decimals is the amount of decimal digits.
+	decimals int
+	// tests tells whether the tests and fuzz targets are generated.
+	tests bool
+}
+
+func main() {
+	var cfg config
+
+	flag.StringVar(&cfg.src, "src", "", "directory of the moedinha package (default: resolved from the current module)")
+	flag.StringVar(&cfg.out, "out", ".", "output directory of the generated package")
+	flag.StringVar(&cfg.pkg, "package", "", "name of the generated package")
+	flag.StringVar(&cfg.typeName, "type", sourceTypeName, "name of the generated Currency type")
+	flag.IntVar(&cfg.uints, "uints", 4, "amount of uint64 used to represent the numbers")
+	flag.IntVar(&cfg.decimals, "decimals", digitsPerUint, "amount of decimal digits")
+	flag.BoolVar(&cfg.tests, "tests", true, "generate the tests and fuzz targets")
+	flag.Parse()
+
+	if err := generate(cfg); err != nil {
+		fmt.Fprintln(os.Stderr, "moedinhagen:", err)
+		os.Exit(1)
+	}
+}
+
+// generate writes the package configured by cfg.
+func generate(cfg config) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+
+	pkg, err := importSource(cfg.src)
+	if err != nil {
+		return err
+	}
+
+	names := pkg.GoFiles
+	if cfg.tests {
+		names = append(names, pkg.TestGoFiles...)
+	}
+
+	fset := token.NewFileSet()
+	files := make(map[string]*ast.File, len(names))
+
+	for _, name := range names {
+		if skippedFiles[name] {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(pkg.Dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return fmt.Errorf("parsing source: %w", err)
+		}
+
+		files[name] = file
+	}
+
+	settings, ok := files[settingsFile]
+	if !ok {
+		return fmt.Errorf("source %s not found in %s", settingsFile, pkg.Dir)
+	}
+
+	if err := cfg.rewriteSettings(settings); err != nil {
+		return err
+	}
+
+	if err := cfg.renameType(files); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cfg.out, 0o755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	for name, file := range files {
+		file.Name.Name = cfg.pkg
+
+		var buf bytes.Buffer
+
+		buf.WriteString(header)
+
+		if err := format.Node(&buf, fset, file); err != nil {
+			return fmt.Errorf("formatting %s: %w", name, err)
+		}
+
+		if err := os.WriteFile(filepath.Join(cfg.out, name), buf.Bytes(), 0o644); err != nil {
+			return fmt.Errorf("writing %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// validate checks the configuration flags.
+func (cfg config) validate() error {
+	if !token.IsIdentifier(cfg.pkg) {
+		return fmt.Errorf("invalid package name: %q", cfg.pkg)
+	}
+
+	if !token.IsIdentifier(cfg.typeName) || !token.IsExported(cfg.typeName) {
+		return fmt.Errorf("invalid type name: %q", cfg.typeName)
+	}
+
+	if cfg.uints < 2 || cfg.uints > maxUints {
+		return fmt.Errorf("invalid amount of uints: %d, between 2 and %d are required", cfg.uints, maxUints)
+	}
+
+	if cfg.decimals < 0 || cfg.decimals >= cfg.uints*digitsPerUint {
+		return fmt.Errorf("invalid decimal digits: %d, at least one of the %d digits should be an integer digit",
+			cfg.decimals, cfg.uints*digitsPerUint)
+	}
+
+	return nil
+}
+
+// importSource returns the source package, found in the src directory or in the moedinha module directory.
+func importSource(src string) (*build.Package, error) {
+	var (
+		pkg *build.Package
+		err error
+	)
+
+	if src == "" {
+		pkg, err = build.Import(importPath, ".", 0)
+	} else {
+		pkg, err = build.ImportDir(src, 0)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("importing source: %w", err)
+	}
+
+	return pkg, nil
+}
+
+// rewriteSettings replaces the settings.go constants by the configured ones.
+func (cfg config) rewriteSettings(file *ast.File) error {
+	values := map[string]int{
+		"numberOfUints":           cfg.uints,
+		"digitsReservedToDecimal": cfg.decimals,
+	}
+
+	found := 0
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+
+			for i, name := range value.Names {
+				v, ok := values[name.Name]
+				if !ok || i >= len(value.Values) {
+					continue
+				}
+
+				value.Values[i] = &ast.BasicLit{
+					ValuePos: value.Values[i].Pos(),
+					Kind:     token.INT,
+					Value:    strconv.Itoa(v),
+				}
+
+				found++
+			}
+		}
+	}
+
+	if found != len(values) {
+		return fmt.Errorf("unexpected %s constants", settingsFile)
+	}
+
+	return nil
+}
+
+// renameType renames the Currency type to the configured type name in all the files, including the comments.
+func (cfg config) renameType(files map[string]*ast.File) error {
+	if cfg.typeName == sourceTypeName {
+		return nil
+	}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			if name, ok := declaresName(decl, cfg.typeName); ok {
+				return fmt.Errorf("type name %q conflicts with the declared %s", cfg.typeName, name)
+			}
+		}
+	}
+
+	for _, file := range files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			if ident, ok := node.(*ast.Ident); ok && ident.Name == sourceTypeName {
+				ident.Name = cfg.typeName
+			}
+
+			return true
+		})
+
+		for _, group := range file.Comments {
+			for _, comment := range group.List {
+				comment.Text = sourceTypeRegexp.ReplaceAllString(comment.Text, cfg.typeName)
+			}
+		}
+	}
+
+	return nil
+}
+
+// declaresName reports whether decl declares the given name at the package level, returning the declaration kind.
+func declaresName(decl ast.Decl, name string) (string, bool) {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		return "function " + name, decl.Recv == nil && decl.Name.Name == name
+	case *ast.GenDecl:
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				if spec.Name.Name == name {
+					return "type " + name, true
+				}
+			case *ast.ValueSpec:
+				for _, ident := range spec.Names {
+					if ident.Name == name {
+						return decl.Tok.String() + " " + name, true
+					}
+				}
+			}
+		}
+	}
+
+	return "", false
+}
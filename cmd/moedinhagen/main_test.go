@@ -0,0 +1,130 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// generatedFuzzTargets are the fuzz targets run against the generated packages. The fuzz targets have no seed
+// corpus, so they're run with a fixed amount of inputs instead of the seed corpus only.
+var generatedFuzzTargets = []string{"FuzzAddSub", "FuzzMul", "FuzzDiv"}
+
+func TestGenerate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the generated package tests in short mode")
+	}
+
+	// The decimal digits aren't multiples of the uint digits, so the rounding and the overflows are tested
+	// with decimal digits split across the uints, besides the integers only scale.
+	for _, decimals := range []int{0, 2, 4, 8} {
+		t.Run(strconv.Itoa(decimals), func(t *testing.T) {
+			t.Parallel()
+
+			testGenerate(t, decimals)
+		})
+	}
+}
+
+// testGenerate generates a package with the given decimal digits, and runs its tests and fuzz targets.
+func testGenerate(t *testing.T, decimals int) {
+	// The output directory is created inside the module, so the generated tests can use its dependencies, and it's
+	// prefixed by an underscore, so it's ignored by the ./... patterns.
+	out, err := os.MkdirTemp(".", "_generated")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		os.RemoveAll(out)
+	})
+
+	cfg := config{
+		src:      filepath.Join("..", ".."),
+		out:      out,
+		pkg:      "money",
+		typeName: "Amount",
+		uints:    3,
+		decimals: decimals,
+		tests:    true,
+	}
+
+	if err := generate(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	settings, err := os.ReadFile(filepath.Join(out, settingsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSettings := []string{header, "package money", "numberOfUints = 3", "digitsReservedToDecimal = " + strconv.Itoa(decimals)}
+
+	for _, expected := range expectedSettings {
+		if !strings.Contains(string(settings), expected) {
+			t.Errorf("expected %s to contain %q:\n%s", settingsFile, expected, settings)
+		}
+	}
+
+	pkg := "./" + filepath.ToSlash(out)
+
+	commands := [][]string{{"test", "-run=^Test", pkg}}
+
+	for _, target := range generatedFuzzTargets {
+		commands = append(commands, []string{"test", "-run=^$", "-fuzz=^" + target + "$", "-fuzztime=1000x", pkg})
+	}
+
+	for _, args := range commands {
+		if output, err := exec.Command("go", args...).CombinedOutput(); err != nil {
+			t.Fatalf("testing the generated package with %q: %v\n%s", strings.Join(args, " "), err, output)
+		}
+	}
+}
+
+func TestGenerateInvalidConfig(t *testing.T) {
+	valid := config{pkg: "money", typeName: "Amount", uints: 4, decimals: 2, tests: true}
+
+	tests := map[string]func(cfg *config){
+		"package name":         func(cfg *config) { cfg.pkg = "1money" },
+		"unexported type name": func(cfg *config) { cfg.typeName = "amount" },
+		"single uint":          func(cfg *config) { cfg.uints = 1 },
+		"too many uints":       func(cfg *config) { cfg.uints = 18 },
+		"negative decimals":    func(cfg *config) { cfg.decimals = -1 },
+		"only decimals":        func(cfg *config) { cfg.decimals = 72 },
+	}
+
+	for name, change := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := valid
+			change(&cfg)
+
+			if err := cfg.validate(); err == nil {
+				t.Errorf("expected an error validating %+v", cfg)
+			}
+		})
+	}
+
+	for _, cfg := range []config{valid, {pkg: "money", typeName: "Amount", uints: 4, decimals: 0, tests: true}} {
+		if err := cfg.validate(); err != nil {
+			t.Errorf("unexpected error validating %+v: %v", cfg, err)
+		}
+	}
+}
+
+func TestGenerateTypeNameConflict(t *testing.T) {
+	cfg := config{
+		src:      filepath.Join("..", ".."),
+		out:      t.TempDir(),
+		pkg:      "money",
+		typeName: "Fixed",
+		uints:    4,
+		decimals: 18,
+	}
+
+	if err := generate(cfg); err == nil || !strings.Contains(err.Error(), "conflicts") {
+		t.Errorf("expected a type name conflict error, got %v", err)
+	}
+}
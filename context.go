@@ -0,0 +1,199 @@
+package moedinha
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Condition is a set of exceptional conditions that can be raised by the Context operations, following the
+// General Decimal Arithmetic specification. Conditions can be combined with the | operator.
+type Condition uint8
+
+const (
+	// Overflow is raised when the result doesn't fit in the supported digits. The untrapped result is
+	// clamped to MaxValue or MinValue, and the Inexact and Rounded conditions are also raised.
+	Overflow Condition = 1 << iota
+	// Inexact is raised when non-zero digits were discarded from the result.
+	Inexact
+	// Rounded is raised when digits were discarded from the result, even if they were zeros. Multiplications
+	// always discard the extra decimal digits of the product, and rounding to fewer places than the supported
+	// decimal digits always discards the remaining places.
+	Rounded
+	// DivisionByZero is raised when a non-zero number is divided by zero. The untrapped result is clamped to
+	// MaxValue or MinValue, following the sign of the dividend.
+	DivisionByZero
+	// InvalidOperation is raised when the result isn't defined, like zero divided by zero.
+	// The untrapped result is zero.
+	InvalidOperation
+)
+
+// conditionNames stores the condition names in the bit order.
+var conditionNames = [...]string{"Overflow", "Inexact", "Rounded", "DivisionByZero", "InvalidOperation"}
+
+// String returns the names of the conditions in the set, separated by "|".
+func (c Condition) String() string {
+	var names []string
+
+	for i, name := range conditionNames {
+		if c&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, "|")
+}
+
+// err returns the error sentinel of the most severe condition in the set, or nil for an empty set.
+func (c Condition) err() error {
+	switch {
+	case c&InvalidOperation != 0:
+		return ErrInvalidOperation
+	case c&DivisionByZero != 0:
+		return ErrDivisionByZero
+	case c&Overflow != 0:
+		return ErrOverflow
+	case c&Inexact != 0:
+		return ErrInexact
+	case c&Rounded != 0:
+		return ErrRounded
+	default:
+		return nil
+	}
+}
+
+// Context holds the rounding mode, the trapped conditions, and the accumulated status flags of a sequence of
+// operations. Every condition raised by an operation is added to the flags, and trapped conditions also make
+// the operation return an error wrapping the condition sentinel, like ErrOverflow. Since the flags are updated
+// by the operations, a Context shouldn't be shared by concurrent goroutines. Like Calc, the Context operations
+// only work on Currency.
+type Context struct {
+	// Rounding is the rounding mode used by the operations that discard digits.
+	Rounding RoundingMode
+	// Traps are the conditions that make the operations return an error.
+	Traps Condition
+	// Flags are the conditions raised by the operations since the last time they were cleared.
+	Flags Condition
+}
+
+// raise adds the conditions to the context flags, returning the error sentinel of the trapped ones, if any.
+func (ctx *Context) raise(conditions Condition) error {
+	ctx.Flags |= conditions
+
+	return (conditions & ctx.Traps).err()
+}
+
+// Add returns x + y.
+func (ctx *Context) Add(x, y Currency) (Currency, error) {
+	result, overflow := x.t.add(y.t)
+	if !overflow {
+		return Currency{result}, nil
+	}
+
+	if err := ctx.raise(Overflow | Inexact | Rounded); err != nil {
+		return Currency{}, fmt.Errorf("calculating %s + %s: %w", x.String(), y.String(), err)
+	}
+
+	// The overflowing result always has the sign of x.
+	return saturated[DefaultPrecision](x.t.isNeg()), nil
+}
+
+// Sub returns x - y.
+func (ctx *Context) Sub(x, y Currency) (Currency, error) {
+	result, overflow := x.t.sub(y.t)
+	if !overflow {
+		return Currency{result}, nil
+	}
+
+	if err := ctx.raise(Overflow | Inexact | Rounded); err != nil {
+		return Currency{}, fmt.Errorf("calculating %s - %s: %w", x.String(), y.String(), err)
+	}
+
+	// The overflowing result always has the sign of x.
+	return saturated[DefaultPrecision](x.t.isNeg()), nil
+}
+
+// Mul returns x * y, rounded to the supported decimal digits using the context rounding mode.
+func (ctx *Context) Mul(x, y Currency) (Currency, error) {
+	result, exact, overflow := x.mulRound(y, ctx.Rounding)
+
+	conditions := Rounded
+
+	switch {
+	case overflow:
+		conditions |= Overflow | Inexact
+		result = saturated[DefaultPrecision](x.t.isNeg() != y.t.isNeg())
+	case !exact:
+		conditions |= Inexact
+	}
+
+	if err := ctx.raise(conditions); err != nil {
+		return Currency{}, fmt.Errorf("calculating %s * %s: %w", x.String(), y.String(), err)
+	}
+
+	return result, nil
+}
+
+// Div returns x / y, rounded to the supported decimal digits using the context rounding mode.
+func (ctx *Context) Div(x, y Currency) (Currency, error) {
+	var (
+		result     Currency
+		conditions Condition
+	)
+
+	switch {
+	case y.t.isZero() && x.t.isZero():
+		conditions = InvalidOperation
+	case y.t.isZero():
+		conditions = DivisionByZero
+		result = saturated[DefaultPrecision](x.t.isNeg() != y.t.isNeg())
+	default:
+		var exact, overflow bool
+
+		result, exact, overflow = x.divRound(y, currencyDecimalDigits, ctx.Rounding)
+
+		switch {
+		case overflow:
+			conditions = Overflow | Inexact | Rounded
+			result = saturated[DefaultPrecision](x.t.isNeg() != y.t.isNeg())
+		case !exact:
+			conditions = Inexact | Rounded
+		}
+	}
+
+	if err := ctx.raise(conditions); err != nil {
+		return Currency{}, fmt.Errorf("calculating %s / %s: %w", x.String(), y.String(), err)
+	}
+
+	return result, nil
+}
+
+// Round rounds x to the given decimal places using the context rounding mode.
+// Negative places rounds the integer part, e.g. -2 rounds to hundreds.
+func (ctx *Context) Round(x Currency, places int) (Currency, error) {
+	digits := currencyDecimalDigits - places
+
+	n, overflow := x.t.abs().round(digits, ctx.Rounding, x.t.isNeg(), false)
+
+	result := Currency{t: newInteger(n, x.t.isNeg())}
+
+	var conditions Condition
+
+	if digits > 0 {
+		conditions |= Rounded
+	}
+
+	if x.t.abs().hasDigitsBelow(digits) {
+		conditions |= Inexact
+	}
+
+	if overflow {
+		conditions |= Overflow | Inexact | Rounded
+		result = saturated[DefaultPrecision](x.t.isNeg())
+	}
+
+	if err := ctx.raise(conditions); err != nil {
+		return Currency{}, fmt.Errorf("rounding %s to %d places: %w", x.String(), places, err)
+	}
+
+	return result, nil
+}
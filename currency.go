@@ -3,13 +3,12 @@ package moedinha
 import (
 	"errors"
 	"fmt"
-	"regexp"
-	"strings"
+	"math"
 )
 
 const (
 	// currencyDecimalDigits defines how many decimal digits should be used.
-	currencyDecimalDigits = uintsReservedToDecimal * maxDigitsPerUint
+	currencyDecimalDigits = digitsReservedToDecimal
 	// currencyMaxIntegerDigits the amount of digits before the decimal pointer.
 	currencyMaxIntegerDigits = (numberOfUints * maxDigitsPerUint) - currencyDecimalDigits
 	// currencyDecimalSeparatorSymbol the separator used for decimal digits.
@@ -20,169 +19,890 @@ const (
 )
 
 var (
-	ErrInvalidFormat = errors.New("invalid format")
-
-	currencyRegexp = regexp.MustCompile(fmt.Sprintf(
-		`^-?\d{1,%d}(\%c\d{0,%d})?$`,
-		currencyMaxIntegerDigits,
-		currencyDecimalSeparatorSymbol,
-		currencyDecimalDigits,
-	))
+	ErrInvalidFormat    = errors.New("invalid format")
+	ErrOverflow         = errors.New("overflow")
+	ErrDivisionByZero   = errors.New("division by zero")
+	ErrInvalidOperation = errors.New("invalid operation")
+	ErrInexact          = errors.New("inexact result")
+	ErrRounded          = errors.New("rounded result")
 )
 
-type Currency struct {
-	t integer
-}
+// one is the Currency representation of the number 1.
+var one = Currency{t: integer(pow10Natural(currencyDecimalDigits))}
 
+// NewFromString returns the Currency represented by str, e.g. "-123.45". An error wrapping ErrInvalidFormat
+// is returned if str isn't a valid number, or if it has more integer or decimal digits than the supported.
 func NewFromString(str string) (Currency, error) {
-	if !currencyRegexp.MatchString(str) {
-		return Currency{}, fmt.Errorf(`validating currency: "%s": %w`, str, ErrInvalidFormat)
+	return parse(str)
+}
+
+// NewFromBytes returns the Currency represented by the text in b, like NewFromString.
+func NewFromBytes(b []byte) (Currency, error) {
+	return parse(b)
+}
+
+// parse validates str and accumulates its digits in a single pass. The digits are accumulated in chunks of
+// maxDigitsPerUint digits, which are shifted into the natural number as whole uints, and the number is then
+// multiplied by the power of ten of the missing decimal digits.
+func parse[S string | []byte](str S) (Currency, error) {
+	var (
+		n                            natural
+		chunk                        uint64
+		chunkDigits                  int
+		integerDigits, decimalDigits int
+		separator, neg               bool
+	)
+
+	i := 0
+	if len(str) > 0 && str[0] == integerNegativeSymbol {
+		neg = true
+		i++
 	}
 
-	separatorIndex := strings.IndexRune(str, currencyDecimalSeparatorSymbol)
+	for ; i < len(str); i++ {
+		ch := str[i]
+
+		if ch == currencyDecimalSeparatorSymbol && !separator && integerDigits > 0 {
+			separator = true
+
+			continue
+		}
+
+		digit := ch - zeroRune
+		if digit > base-1 {
+			return Currency{}, fmt.Errorf(`validating currency: "%s": %w`, string(str), ErrInvalidFormat)
+		}
+
+		if separator {
+			decimalDigits++
+		} else {
+			integerDigits++
+		}
+
+		if integerDigits > currencyMaxIntegerDigits || decimalDigits > currencyDecimalDigits {
+			return Currency{}, fmt.Errorf(`validating currency: "%s": %w`, string(str), ErrInvalidFormat)
+		}
+
+		chunk = chunk*base + uint64(digit)
+		chunkDigits++
+
+		// Since there are at most naturalMaxLen digits, the first uint is zero before the last chunk.
+		if chunkDigits == maxDigitsPerUint {
+			copy(n[:], n[1:])
+			n[numberOfUints-1] = chunk
+			chunk, chunkDigits = 0, 0
+		}
+	}
 
-	strLen := len(str)
+	if integerDigits == 0 {
+		return Currency{}, fmt.Errorf(`validating currency: "%s": %w`, string(str), ErrInvalidFormat)
+	}
 
-	decimalDigits := 0
-	integerDigits := strLen
-	if separatorIndex >= 0 {
-		decimalDigits = strLen - (separatorIndex + 1)
-		integerDigits -= decimalDigits + 1
+	if chunkDigits > 0 {
+		// The lower chunkDigits digits of the last uint are zero after the multiplication.
+		n, _ = n.mulByUint64(pow10[chunkDigits])
+		n[numberOfUints-1] += chunk
 	}
 
-	// How much the copy should shift in integer number string. For example:
-	// 0.1 is shifted to left by 17 digits if the support is for 18 digits.
-	cpRightShift := currencyDecimalDigits - decimalDigits
-	cpLeftShift := integerMaxLen - cpRightShift - (decimalDigits + integerDigits)
+	// Since there are at most currencyMaxIntegerDigits integer digits, the result can't overflow.
+	n, _ = n.mulPow10(currencyDecimalDigits - decimalDigits)
+
+	return Currency{t: newInteger(n, neg)}, nil
+}
+
+func (c Fixed[P]) String() string {
+	var str [currencyMaxLen]byte
+
+	text, _ := c.AppendText(str[:0])
 
-	intString := [integerMaxLen]byte{zeroRune}
+	return string(text)
+}
+
+func (c Fixed[P]) IsZero() bool {
+	return c.t.isZero()
+}
 
-	// Copy the integer part.
-	copy(intString[cpLeftShift:cpLeftShift+integerDigits], str[:integerDigits])
-	// Copying the decimal part, if any.
-	if decimalDigits > 0 {
-		copy(intString[cpLeftShift+integerDigits:cpLeftShift+integerDigits+decimalDigits], str[integerDigits+1:])
+// Sign returns -1 if c is negative, 0 if c is zero, and 1 if c is positive.
+func (c Fixed[P]) Sign() int {
+	switch {
+	case c.t.isZero():
+		return 0
+	case c.t.isNeg():
+		return -1
+	default:
+		return 1
 	}
+}
 
-	// Adding leading zeros.
-	copy(intString[:cpLeftShift], zeroFiller[:])
-	// Adding trailing zeros.
-	copy(intString[currencyMaxLen-(cpRightShift+1):], zeroFiller[:])
+// Neg returns -c.
+func (c Fixed[P]) Neg() Fixed[P] {
+	return Fixed[P]{t: newInteger(c.t.abs(), !c.t.isNeg())}
+}
 
-	if cpLeftShift != 0 && intString[cpLeftShift] == integerNegativeSymbol {
-		intString[0] = integerNegativeSymbol
-		intString[cpLeftShift] = zeroRune
+// Abs returns the absolute value of c.
+func (c Fixed[P]) Abs() Fixed[P] {
+	return Fixed[P]{t: newInteger(c.t.abs(), false)}
+}
+
+func (c Fixed[P]) Equal(v Fixed[P]) bool {
+	return c.t.equal(v.t)
+}
+
+func (c Fixed[P]) GreaterThan(v Fixed[P]) bool {
+	return c.t.greaterThan(v.t)
+}
+
+func (c Fixed[P]) GreaterThanOrEqual(v Fixed[P]) bool {
+	return c.t.greaterThanOrEqual(v.t)
+}
+
+func (c Fixed[P]) LessThan(v Fixed[P]) bool {
+	return c.t.lessThan(v.t)
+}
+
+func (c Fixed[P]) LessThanOrEqual(v Fixed[P]) bool {
+	return c.t.lessThanOrEqual(v.t)
+}
+
+// Add returns c + v. This operation panics on overflow (see AddChecked).
+func (c Fixed[P]) Add(v Fixed[P]) Fixed[P] {
+	result, overflow := c.add(v)
+	if overflow {
+		panic(fmt.Sprintf("addition overflow: %s + %s", c.String(), v.String()))
 	}
 
-	intValue, err := newIntegerFromString(intString)
-	if err != nil {
-		return Currency{}, fmt.Errorf("creating underlying integer: %w", err)
+	return result
+}
+
+// add is the Add implementation, but reporting the overflow at the second return instead of panicking.
+func (c Fixed[P]) add(v Fixed[P]) (Fixed[P], bool) {
+	result, overflow := c.t.add(v.t)
+
+	return Fixed[P]{result}, overflow || !fitsAbs[P](&result)
+}
+
+// Sub returns c - v. This operation panics on overflow (see SubChecked).
+func (c Fixed[P]) Sub(v Fixed[P]) Fixed[P] {
+	result, overflow := c.sub(v)
+	if overflow {
+		panic(fmt.Sprintf("subtraction overflow: %s - %s", c.String(), v.String()))
 	}
 
-	return Currency{t: intValue}, nil
+	return result
 }
 
-func (c Currency) String() string {
-	if c.t.isZero() {
-		return "0"
+// sub is the Sub implementation, but reporting the overflow at the second return instead of panicking.
+func (c Fixed[P]) sub(v Fixed[P]) (Fixed[P], bool) {
+	result, overflow := c.t.sub(v.t)
+
+	return Fixed[P]{result}, overflow || !fitsAbs[P](&result)
+}
+
+// AddInt64 returns c + x, without parsing x as a Currency. When the decimal digits fill whole uints and the
+// result keeps the sign of c, only the integer uints of c are updated. This operation panics on overflow.
+func (c Fixed[P]) AddInt64(x int64) Fixed[P] {
+	abs, neg := absInt64(x), x < 0
+
+	if currencyDecimalDigits%maxDigitsPerUint == 0 {
+		// The index of the least significant integer uint.
+		const index = numberOfUints - 1 - currencyDecimalDigits/maxDigitsPerUint
+
+		if c.t.isNeg() == neg {
+			n, overflow := c.t.abs().addUint64(index, abs)
+			if overflow || !fits[P](n) {
+				panic(fmt.Sprintf("addition overflow: %s + %d", c.String(), x))
+			}
+
+			return Fixed[P]{t: newInteger(n, neg)}
+		}
+
+		if n, borrow := c.t.abs().subUint64(index, abs); borrow == 0 {
+			return Fixed[P]{t: newInteger(n, c.t.isNeg())}
+		}
 	}
 
-	intString := c.t.string()
+	// Calculating |x|.10^currencyDecimalDigits as a double precision number, where the overflow of x itself
+	// only happens with a single uint.
+	low, high := newNatFromUint64(abs)
+	n, overflow := low.mulPow10(currencyDecimalDigits)
+	highResult, highOverflow := high.mulPow10(currencyDecimalDigits)
 
-	currString := [currencyMaxLen]byte{}
-	// Copy integer part, preserving 0 index to negative symbol.
-	copy(currString[:currencyMaxIntegerDigits+1], intString[:currencyMaxIntegerDigits+1])
-	// Setting the decimal separator.
-	currString[currencyMaxIntegerDigits+1] = currencyDecimalSeparatorSymbol
-	// Copying the decimal part.
-	copy(currString[currencyMaxIntegerDigits+2:], intString[currencyMaxIntegerDigits+1:])
+	overflow, carry := overflow.addOverflow(highResult)
+	if !highOverflow.isZero() || carry > 0 {
+		panic(fmt.Sprintf("addition overflow: %s + %d", c.String(), x))
+	}
 
-	var leftZerosToRemove int
-	for i := 1; i < currencyMaxLen; i++ {
-		if currString[i] != zeroRune {
-			break
+	if !overflow.isZero() {
+		// Since |c| is lesser than 10^naturalMaxLen, the sum only fits when c has the opposite sign, and
+		// subtracting |c| borrows the single unit of the overflow.
+		var diff natural
+
+		cn := c.t.abs()
+
+		if c.t.isNeg() == neg || overflow != pow10Natural(0) || !subNaturals(&diff, &n, &cn) || !fits[P](diff) {
+			panic(fmt.Sprintf("addition overflow: %s + %d", c.String(), x))
 		}
 
-		leftZerosToRemove++
+		return Fixed[P]{t: newInteger(diff, neg)}
+	}
+
+	result, over := c.add(Fixed[P]{t: newInteger(n, neg)})
+	if over {
+		panic(fmt.Sprintf("addition overflow: %s + %d", c.String(), x))
 	}
 
-	var rightZerosToRemove int
-	for i := currencyMaxLen - 1; i >= 0; i-- {
-		if currString[i] != zeroRune {
-			break
+	return result
+}
+
+// Mul returns c * v, rounding the result to the supported decimal digits using DefaultRoundingMode.
+// This operation panics on overflow (see MulChecked).
+func (c Fixed[P]) Mul(v Fixed[P]) Fixed[P] {
+	result, _, overflow := c.mulRound(v, DefaultRoundingMode)
+	if overflow {
+		panic(fmt.Sprintf("multiplication overflow: %s * %s", c.String(), v.String()))
+	}
+
+	return result
+}
+
+// MulRound returns c * v, rounding the result to the supported decimal digits using the given rounding mode.
+// The second return reports whether the result is exact, i.e. no non-zero digit was discarded.
+// This operation panics on overflow.
+func (c Fixed[P]) MulRound(v Fixed[P], mode RoundingMode) (Fixed[P], bool) {
+	result, exact, overflow := c.mulRound(v, mode)
+	if overflow {
+		panic(fmt.Sprintf("multiplication overflow: %s * %s", c.String(), v.String()))
+	}
+
+	return result, exact
+}
+
+// mulRound is the MulRound implementation, but reporting the overflow at the last return instead of panicking.
+func (c Fixed[P]) mulRound(v Fixed[P], mode RoundingMode) (Fixed[P], bool, bool) {
+	// Since integers and naturals represents numbers with currencyDecimalDigits decimal
+	// digits, the product represents a number with 2*currencyDecimalDigits decimal digits.
+	// There's a need to round the first currencyDecimalDigits from the product.
+	var product wide
+
+	c.t.mulAbs(v.t, &product)
+
+	neg := c.t.isNeg() != v.t.isNeg()
+
+	exact := !product.hasDigitsBelow(currencyDecimalDigits)
+
+	n, overflow := product.toNatural(currencyDecimalDigits, roundingFor[P](mode), neg)
+	if overflow {
+		return Fixed[P]{}, false, true
+	}
+
+	return newFixed[P](n, neg, !exact, mode)
+}
+
+// MulAdd returns c * v + a, rounding the result to the supported decimal digits using DefaultRoundingMode.
+// The product is kept with double precision before the addition, so the result is rounded a single time,
+// unlike c.Mul(v).Add(a). This operation panics on overflow (see MulAddChecked).
+func (c Fixed[P]) MulAdd(v, a Fixed[P]) Fixed[P] {
+	result, _ := c.MulAddRound(v, a, DefaultRoundingMode)
+
+	return result
+}
+
+// MulAddRound returns c * v + a, rounding the result to the supported decimal digits using the given rounding
+// mode. The second return reports whether the result is exact, i.e. no non-zero digit was discarded.
+// This operation panics on overflow.
+func (c Fixed[P]) MulAddRound(v, a Fixed[P], mode RoundingMode) (Fixed[P], bool) {
+	result, exact, overflow := c.mulAddRound(v, a, mode)
+	if overflow {
+		panic(fmt.Sprintf("multiplication overflow: %s * %s + %s", c.String(), v.String(), a.String()))
+	}
+
+	return result, exact
+}
+
+// mulAddRound is the MulAddRound implementation, but reporting the overflow at the last return instead of
+// panicking.
+func (c Fixed[P]) mulAddRound(v, a Fixed[P], mode RoundingMode) (Fixed[P], bool, bool) {
+	var product wide
+
+	c.t.mulAbs(v.t, &product)
+
+	// The product represents a number with 2*currencyDecimalDigits decimal digits, so the addend is
+	// shifted left by the decimal digits to be aligned with it.
+	var addend wide
+
+	addendLo, addendHi := a.t.abs().mulPow10(currencyDecimalDigits)
+
+	copy(addend[:numberOfUints], addendHi[:])
+	copy(addend[numberOfUints:], addendLo[:])
+
+	sum, neg, overflow := addSigned(product, c.t.isNeg() != v.t.isNeg(), addend, a.t.isNeg())
+	if overflow {
+		return Fixed[P]{}, false, true
+	}
+
+	exact := !sum.hasDigitsBelow(currencyDecimalDigits)
+
+	n, overflow := sum.toNatural(currencyDecimalDigits, roundingFor[P](mode), neg)
+	if overflow {
+		return Fixed[P]{}, false, true
+	}
+
+	return newFixed[P](n, neg, !exact, mode)
+}
+
+// MulInt64 returns c * x. Since x has no decimal digits, the product is always exact, and it's calculated
+// with a single uint multiplication per uint of c. This operation panics on overflow.
+func (c Fixed[P]) MulInt64(x int64) Fixed[P] {
+	result, overflow := c.mulUint64(absInt64(x), x < 0)
+	if overflow {
+		panic(fmt.Sprintf("multiplication overflow: %s * %d", c.String(), x))
+	}
+
+	return result
+}
+
+// MulUint64 returns c * x, like MulInt64. This operation panics on overflow.
+func (c Fixed[P]) MulUint64(x uint64) Fixed[P] {
+	result, overflow := c.mulUint64(x, false)
+	if overflow {
+		panic(fmt.Sprintf("multiplication overflow: %s * %d", c.String(), x))
+	}
+
+	return result
+}
+
+// mulUint64 returns c * x, where neg tells whether x is negative.
+// The second return reports whether the result overflows.
+func (c Fixed[P]) mulUint64(x uint64, neg bool) (Fixed[P], bool) {
+	n := c.t.abs()
+
+	carry := mulUintsByUint(n[:], x)
+
+	return Fixed[P]{t: newInteger(n, c.t.isNeg() != neg)}, carry != 0 || !fits[P](n)
+}
+
+// Div returns c / v, rounding the quotient to the supported decimal digits using DefaultRoundingMode.
+// This operation panics on division by zero and on overflow (see DivChecked).
+func (c Fixed[P]) Div(v Fixed[P]) Fixed[P] {
+	return c.DivRound(v, currencyDecimalDigits, DefaultRoundingMode)
+}
+
+// DivRound returns c / v, rounding the quotient to the given decimal places using the given rounding mode.
+// Negative places rounds the integer part, e.g. -2 rounds to hundreds. Places greater than the supported
+// decimal digits are handled as the supported decimal digits. This operation panics on division by zero
+// and on overflow (see DivRoundChecked).
+func (c Fixed[P]) DivRound(v Fixed[P], places int, mode RoundingMode) Fixed[P] {
+	if v.t.isZero() {
+		panic(fmt.Sprintf("division by zero: %s / %s", c.String(), v.String()))
+	}
+
+	result, _, overflow := c.divRound(v, places, mode)
+	if overflow {
+		panic(fmt.Sprintf("division overflow: %s / %s", c.String(), v.String()))
+	}
+
+	return result
+}
+
+// divRound is the DivRound implementation, reporting whether the quotient is exact at the second return,
+// and the overflow at the last return instead of panicking. The divisor should be non-zero.
+func (c Fixed[P]) divRound(v Fixed[P], places int, mode RoundingMode) (Fixed[P], bool, bool) {
+	// Since both integers represents numbers with currencyDecimalDigits decimal digits, the dividend
+	// should be shifted by currencyDecimalDigits to keep those digits in the quotient.
+	dividend, dividendOverflow := c.t.abs().mulPow10(currencyDecimalDigits)
+
+	neg := c.t.isNeg() != v.t.isNeg()
+
+	decimals, _ := precisionOf[P]()
+
+	quo, exact, overflow := quoRound(dividendOverflow, dividend, v.t.abs(), min(places, decimals), mode, neg)
+
+	return Fixed[P]{t: newInteger(quo, neg)}, exact, overflow || !fits[P](quo)
+}
+
+// DivInt64 returns c / x, rounding the quotient to the supported decimal digits using DefaultRoundingMode.
+// Since x has no decimal digits, the quotient is calculated with a single uint division per uint of c.
+// This operation panics on division by zero.
+func (c Fixed[P]) DivInt64(x int64) Fixed[P] {
+	if x == 0 {
+		panic(fmt.Sprintf("division by zero: %s / %d", c.String(), x))
+	}
+
+	d, neg := absInt64(x), c.t.isNeg() != (x < 0)
+
+	quo := c.t.abs()
+
+	rem := shortDivision(quo[:], quo[:], d)
+
+	if rem != 0 {
+		// Since rem < d <= 2^63, 2*rem can't overflow.
+		var half int
+
+		switch {
+		case 2*rem < d:
+			half = -1
+		case 2*rem > d:
+			half = 1
+		}
+
+		if roundingFor[P](DefaultRoundingMode).roundsUp(neg, quo[numberOfUints-1]%2 == 1, half, true) {
+			// Since |x| >= 1 and a non-zero digit was discarded, the quotient can't overflow.
+			quo = quo.add(pow10Natural(0))
 		}
+	}
+
+	// Since |x| >= 1, the rounded quotient is lesser or equal than |c|, and it can't overflow.
+	result, _, _ := newFixed[P](quo, neg, rem != 0, DefaultRoundingMode)
+
+	return result
+}
+
+// MulDiv returns c * v / d, rounding the result to the supported decimal digits using DefaultRoundingMode.
+// The product is kept with double precision before the division, so it can't
+// overflow or lose digits in the intermediate step.
+// This operation panics on division by zero and on overflow (see MulDivChecked).
+func (c Fixed[P]) MulDiv(v, d Fixed[P]) Fixed[P] {
+	return c.MulDivRound(v, d, currencyDecimalDigits, DefaultRoundingMode)
+}
+
+// MulDivRound returns c * v / d, rounding the result to the given decimal places using the given
+// rounding mode. The product is kept with double precision before the division, so it can't
+// overflow or lose digits in the intermediate step. The places argument follows the DivRound rules.
+// This operation panics on division by zero and on overflow (see MulDivRoundChecked).
+func (c Fixed[P]) MulDivRound(v, d Fixed[P], places int, mode RoundingMode) Fixed[P] {
+	if d.t.isZero() {
+		panic(fmt.Sprintf("division by zero: %s * %s / %s", c.String(), v.String(), d.String()))
+	}
+
+	result, overflow := c.mulDivRound(v, d, places, mode)
+	if overflow {
+		panic(fmt.Sprintf("division overflow: %s * %s / %s", c.String(), v.String(), d.String()))
+	}
+
+	return result
+}
+
+// mulDivRound is the MulDivRound implementation, but reporting the overflow at the last return instead of
+// panicking. The divisor should be non-zero.
+func (c Fixed[P]) mulDivRound(v, d Fixed[P], places int, mode RoundingMode) (Fixed[P], bool) {
+	// The product represents a number with 2*currencyDecimalDigits decimal digits, and the division
+	// by a number with currencyDecimalDigits decimal digits results in a number with exactly
+	// currencyDecimalDigits decimal digits.
+	var product, productOverflow natural
+
+	var w wide
+
+	c.t.mulAbs(v.t, &w)
+
+	copy(productOverflow[:], w[:numberOfUints])
+	copy(product[:], w[numberOfUints:])
+
+	neg := (c.t.isNeg() != v.t.isNeg()) != d.t.isNeg()
+
+	decimals, _ := precisionOf[P]()
 
-		rightZerosToRemove++
+	quo, _, overflow := quoRound(productOverflow, product, d.t.abs(), min(places, decimals), mode, neg)
+
+	return Fixed[P]{t: newInteger(quo, neg)}, overflow || !fits[P](quo)
+}
+
+// quoRound divides the double-width natural number formed by "hi" and "lo" by v, rounding the quotient to
+// the given decimal places using the given rounding mode. The neg argument tells whether the quotient is
+// negative. The second return reports whether the quotient is exact, i.e. no non-zero digit was discarded,
+// and the last return reports whether the quotient overflowed.
+func quoRound(hi, lo, v natural, places int, mode RoundingMode, neg bool) (natural, bool, bool) {
+	quoOverflow, quo, rem := quoRemWide(hi, lo, v)
+	if !quoOverflow.isZero() {
+		return natural{}, false, true
 	}
 
-	// Removing decimal separator if it's an integer number, e.g: "1.0" turn into "1"
-	if currString[currencyMaxLen-rightZerosToRemove-1] == currencyDecimalSeparatorSymbol {
-		rightZerosToRemove++
+	if places < currencyDecimalDigits {
+		exact := rem.isZero() && !quo.hasDigitsBelow(currencyDecimalDigits-places)
+
+		quo, overflow := quo.round(currencyDecimalDigits-places, mode, neg, !rem.isZero())
+
+		return quo, exact, overflow
 	}
 
-	// Preserving at least one 0 at left side of separator, e.g: ".1" turn into "0.1"
-	if currString[leftZerosToRemove+1] == currencyDecimalSeparatorSymbol {
-		leftZerosToRemove--
+	if rem.isZero() {
+		return quo, true, false
 	}
 
-	if c.t.neg {
-		currString[leftZerosToRemove] = integerNegativeSymbol
-		leftZerosToRemove--
+	if !mode.roundsUp(neg, quo[numberOfUints-1]%2 == 1, compareHalfFromRemainder(rem, v), true) {
+		return quo, false, false
 	}
 
-	return string(currString[leftZerosToRemove+1 : len(currString)-rightZerosToRemove])
+	quo, over := quo.addOverflow(pow10Natural(0))
+
+	return quo, false, over > 0
 }
 
-func (c Currency) IsZero() bool {
-	return c.t.isZero()
+// QuoRem returns the integer quotient and the remainder of c / v, such that q*v + r = c.
+// The quotient is truncated towards zero, so the remainder has the same sign as c.
+// This operation panics on division by zero and on overflow (see QuoRemChecked).
+func (c Fixed[P]) QuoRem(v Fixed[P]) (Fixed[P], Fixed[P]) {
+	if v.t.isZero() {
+		panic(fmt.Sprintf("division by zero: %s / %s", c.String(), v.String()))
+	}
+
+	q, r, overflow := c.quoRem(v)
+	if overflow {
+		panic(fmt.Sprintf("division overflow: %s / %s", c.String(), v.String()))
+	}
+
+	return q, r
 }
 
-func (c Currency) Equal(v Currency) bool {
-	return c.t.equal(v.t)
+// quoRem is the QuoRem implementation, but reporting the overflow at the last return instead of panicking.
+// The divisor should be non-zero.
+func (c Fixed[P]) quoRem(v Fixed[P]) (Fixed[P], Fixed[P], bool) {
+	// Since both integers represents numbers with currencyDecimalDigits decimal digits,
+	// the natural quotient is the integer quotient itself, and the remainder is already
+	// represented with currencyDecimalDigits decimal digits.
+	quo, rem := c.t.abs().quoRem(v.t.abs())
+
+	quo, quoOverflow := quo.mulPow10(currencyDecimalDigits)
+
+	q := Fixed[P]{t: newInteger(quo, c.t.isNeg() != v.t.isNeg())}
+
+	r := Fixed[P]{t: newInteger(rem, c.t.isNeg())}
+
+	return q, r, !quoOverflow.isZero() || !fits[P](quo)
 }
 
-func (c Currency) GreaterThan(v Currency) bool {
-	return c.t.greaterThan(v.t)
+// Mod returns the remainder of c / v, with the same sign as c.
+// This operation panics on division by zero (see ModChecked).
+func (c Fixed[P]) Mod(v Fixed[P]) Fixed[P] {
+	if v.t.isZero() {
+		panic(fmt.Sprintf("division by zero: %s %% %s", c.String(), v.String()))
+	}
+
+	_, rem := c.t.abs().quoRem(v.t.abs())
+
+	return Fixed[P]{t: newInteger(rem, c.t.isNeg())}
 }
 
-func (c Currency) GreaterThanOrEqual(v Currency) bool {
-	return c.t.greaterThanOrEqual(v.t)
+// Round rounds c to the given decimal places using the given rounding mode.
+// Negative places rounds the integer part, e.g. -2 rounds to hundreds.
+// This operation panics on overflow.
+func (c Fixed[P]) Round(places int, mode RoundingMode) Fixed[P] {
+	result, overflow := c.round(places, mode)
+	if overflow {
+		panic(fmt.Sprintf("rounding overflow: %s", c.String()))
+	}
+
+	return result
 }
 
-func (c Currency) LessThan(v Currency) bool {
-	return c.t.lessThan(v.t)
+// round is the Round implementation, but reporting the overflow at the second return instead of panicking.
+func (c Fixed[P]) round(places int, mode RoundingMode) (Fixed[P], bool) {
+	n, overflow := c.t.abs().round(currencyDecimalDigits-places, mode, c.t.isNeg(), false)
+	if overflow || !fits[P](n) {
+		return Fixed[P]{}, true
+	}
+
+	return Fixed[P]{t: newInteger(n, c.t.isNeg())}, false
 }
 
-func (c Currency) LessThanOrEqual(v Currency) bool {
-	return c.t.lessThanOrEqual(v.t)
+// Truncate discards the digits after the given decimal places, i.e. rounds towards zero.
+func (c Fixed[P]) Truncate(places int) Fixed[P] {
+	return c.Round(places, Down)
 }
 
-func (c Currency) Add(v Currency) Currency {
-	return Currency{c.t.add(v.t)}
+// Floor rounds c towards negative infinity at the given decimal places.
+// This operation panics on overflow.
+func (c Fixed[P]) Floor(places int) Fixed[P] {
+	return c.Round(places, Floor)
 }
 
-func (c Currency) Sub(v Currency) Currency {
-	return Currency{c.t.sub(v.t)}
+// Ceil rounds c towards positive infinity at the given decimal places.
+// This operation panics on overflow.
+func (c Fixed[P]) Ceil(places int) Fixed[P] {
+	return c.Round(places, Ceiling)
 }
 
-func (c Currency) Mul(v Currency) Currency {
-	intResult, natOverflow := c.t.mul(v.t)
+// Shift returns c.10^n, moving the decimal digits n places to the left for positive n, or to the right for
+// negative n, e.g. shifting reais by 2 results in centavos. An error wrapping ErrOverflow is returned if
+// non-zero digits would be discarded at the left end, and an error wrapping ErrInexact if non-zero digits
+// would be discarded at the right end (see ShiftRound).
+func (c Fixed[P]) Shift(n int) (Fixed[P], error) {
+	result, exact, overflow := c.shiftRound(n, Down)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("shifting %s by %d digits: %w", c.String(), n, ErrOverflow)
+	}
 
-	// Since integers and naturals represents numbers with currencyDecimalDigits decimal
-	// digits, the result represents a number with 2*currencyDecimalDigits decimal digits.
-	// There's a need to truncate the first currencyDecimalDigits from the natural number.
-	intResult.n, _ = intResult.n.padRight(uintsReservedToDecimal)
+	if !exact {
+		return Fixed[P]{}, fmt.Errorf("shifting %s by %d digits: %w", c.String(), n, ErrInexact)
+	}
 
-	// Getting the overflow part that should be summed to result.
-	natOverflow, addToResult := natOverflow.padRight(uintsReservedToDecimal)
+	return result, nil
+}
 
-	intResult.n = intResult.n.add(addToResult)
+// ShiftRound returns c.10^n like Shift, but rounding the digits discarded at the right end using the
+// given rounding mode. An error wrapping ErrOverflow is returned if non-zero digits would be discarded
+// at the left end.
+func (c Fixed[P]) ShiftRound(n int, mode RoundingMode) (Fixed[P], error) {
+	result, _, overflow := c.shiftRound(n, mode)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("shifting %s by %d digits: %w", c.String(), n, ErrOverflow)
+	}
 
-	if !natOverflow.isZero() {
-		panic(fmt.Sprintf("multiplication overflow: %s * %s", c.String(), v.String()))
+	return result, nil
+}
+
+// shiftRound is the ShiftRound implementation, reporting whether the result is exact at the second return,
+// and whether it overflows at the last return.
+func (c Fixed[P]) shiftRound(n int, mode RoundingMode) (Fixed[P], bool, bool) {
+	if n >= 0 {
+		result, overflow := c.t.abs().shiftLeft(n)
+
+		return Fixed[P]{t: newInteger(result, c.t.isNeg())}, true, overflow || !fits[P](result)
 	}
 
-	return Currency{
-		t: intResult,
+	// Any shift beyond the supported digits discards all of them, so clamping it keeps the result,
+	// and avoids the overflow of the digits arithmetic, e.g. for math.MinInt.
+	n = max(n, -naturalMaxLen-1)
+
+	// The digits after the decimal digits of P are also discarded, and then restored as zeros,
+	// so the result is rounded a single time.
+	decimals, _ := precisionOf[P]()
+
+	result, exact := c.t.abs().shiftRight(currencyDecimalDigits-decimals-n, mode, c.t.isNeg())
+
+	// Since more digits were discarded than restored, the result can't overflow.
+	result, _ = result.shiftLeft(currencyDecimalDigits - decimals)
+
+	return Fixed[P]{t: newInteger(result, c.t.isNeg())}, exact, false
+}
+
+// Pow returns c^n, using exponentiation by squaring. Every intermediate product of a non-negative power is
+// rounded to the nearest value with the supported decimal digits (see HalfEven), so the rounding errors don't
+// accumulate in a single direction. Negative powers are calculated as 1/c^(-n), where c^(-n) is calculated
+// with the extra precision of the transcendental functions, and the result is rounded a single time.
+// An error wrapping ErrOverflow is returned if the result doesn't fit in the supported digits, and an
+// error wrapping ErrDivisionByZero is returned for negative powers of zero. Zero to the power of zero is one.
+func (c Fixed[P]) Pow(n int) (Fixed[P], error) {
+	if n < 0 {
+		return c.powNegative(n)
+	}
+
+	unit := Fixed[P]{t: one.t}
+
+	// The unit itself doesn't fit in the precisions without integer digits.
+	if n == 0 && !fits[P](unit.t.abs()) {
+		return Fixed[P]{}, fmt.Errorf("calculating %s^%d: %w", c.String(), n, ErrOverflow)
 	}
+
+	result, square := unit, c
+
+	for exp := n; exp > 0; exp >>= 1 {
+		var overflow bool
+
+		if exp&1 == 1 {
+			result, _, overflow = result.mulRound(square, HalfEven)
+			if overflow {
+				return Fixed[P]{}, fmt.Errorf("calculating %s^%d: %w", c.String(), n, ErrOverflow)
+			}
+		}
+
+		if exp > 1 {
+			square, _, overflow = square.mulRound(square, HalfEven)
+			if overflow {
+				return Fixed[P]{}, fmt.Errorf("calculating %s^%d: %w", c.String(), n, ErrOverflow)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// powNegative is the Pow implementation for a negative n. The power of |c| is calculated by squaring as
+// a floating number, i.e. a wide number between 1 and 10 times a power of 10, so each product keeps the
+// wideDecimalDigits most significant digits, and then its reciprocal is rounded to the supported digits.
+func (c Fixed[P]) powNegative(n int) (Fixed[P], error) {
+	if c.t.isZero() {
+		return Fixed[P]{}, fmt.Errorf("calculating %s^%d: %w", c.String(), n, ErrDivisionByZero)
+	}
+
+	// The conversion keeps the magnitude of math.MinInt.
+	exp := uint(-n)
+
+	neg := c.t.isNeg() && exp&1 == 1
+
+	square, squareExp := newNormalizedWide(c.t.abs(), currencyDecimalDigits)
+	power, powerExp := wideOne, 0
+
+	// Since all the squares are on the same side of 1, the power only moves away from 1, so any square
+	// that is going to be multiplied already tells whether the reciprocal underflows or overflows.
+	// The reciprocal of a power lesser than 10^(exp+1) is greater than 10^(-exp-1), which overflows for
+	// exp < -currencyMaxIntegerDigits, and the reciprocal of a power greater or equal than 10^exp is
+	// lesser or equal than 10^-exp, which is rounded to zero for exp greater than the decimal digits of P.
+	decimals, _ := precisionOf[P]()
+
+	for ; exp > 0; exp >>= 1 {
+		var shift int
+
+		if exp&1 == 1 {
+			power, shift = power.mulNormalized(square)
+			powerExp += squareExp + shift
+		}
+
+		if exp > 1 {
+			square, shift = square.mulNormalized(square)
+			squareExp = 2*squareExp + shift
+		}
+
+		switch {
+		case min(powerExp, squareExp) < -currencyMaxIntegerDigits:
+			return Fixed[P]{}, fmt.Errorf("calculating %s^%d: %w", c.String(), n, ErrOverflow)
+		case max(powerExp, squareExp) > decimals:
+			return Fixed[P]{}, nil
+		}
+	}
+
+	reciprocal, reciprocalExp := power.reciprocalNormalized()
+
+	result, overflow := newFixedFromWide[P](reciprocal, wideDecimalDigits+powerExp-reciprocalExp, neg)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("calculating %s^%d: %w", c.String(), n, ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// Sqrt returns the square root of c, rounded to the nearest value with the supported decimal digits.
+// An error wrapping ErrInvalidOperation is returned for negative values.
+func (c Fixed[P]) Sqrt() (Fixed[P], error) {
+	return c.Root(2)
+}
+
+// Root returns the n-th root of c, rounded to the nearest value with the supported decimal digits (see HalfEven).
+// The odd roots of negative values are negative. An error wrapping ErrInvalidOperation is returned
+// for even roots of negative values, and for n lesser than 1 or greater than 64. An error wrapping
+// ErrOverflow is returned if the rounded root doesn't fit in the integer digits, which can only happen
+// for precisions without integer digits.
+func (c Fixed[P]) Root(n int) (Fixed[P], error) {
+	if n < 1 || n > maxRootDegree {
+		return Fixed[P]{}, fmt.Errorf("calculating %d-th root of %s: unsupported degree: %w", n, c.String(), ErrInvalidOperation)
+	}
+
+	if c.t.isNeg() && !c.t.isZero() && n%2 == 0 {
+		return Fixed[P]{}, fmt.Errorf("calculating %d-th root of %s: even root of negative number: %w", n, c.String(), ErrInvalidOperation)
+	}
+
+	// Since c represents a number with currencyDecimalDigits decimal digits, i.e. c = n/10^currencyDecimalDigits,
+	// the root with currencyDecimalDigits decimal digits is (n.10^((n-1).currencyDecimalDigits))^(1/n).
+	if decimals, _ := precisionOf[P](); decimals == currencyDecimalDigits {
+		r, _ := c.t.abs().root(n, (n-1)*currencyDecimalDigits, true)
+
+		return Fixed[P]{t: newInteger(r, c.t.isNeg())}, nil
+	}
+
+	// The root is truncated, and then rounded a single time to the decimal digits of P.
+	r, exact := c.t.abs().root(n, (n-1)*currencyDecimalDigits, false)
+
+	result, _, overflow := newFixed[P](r, c.t.isNeg(), !exact, HalfEven)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("calculating %d-th root of %s: %w", n, c.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// Exp returns e^c, rounded to the nearest value with the supported decimal digits (see HalfEven).
+// The result is calculated with more than 100 decimal digits of precision before being rounded, so the
+// error is always lesser than one unit of the last decimal digit, and in practice the result is the
+// correctly rounded one. An error wrapping ErrOverflow is returned if the result doesn't fit in the
+// supported digits.
+func (c Fixed[P]) Exp() (Fixed[P], error) {
+	x, overflow := newWideFromNatural(c.t.abs(), currencyDecimalDigits)
+
+	switch xf := x.float64(); {
+	case c.t.isNeg() && (overflow || xf > (currencyDecimalDigits+1)*math.Ln10):
+		// The result is lesser than half unit of the last decimal digit.
+		return Fixed[P]{}, nil
+	case overflow || xf > (currencyMaxIntegerDigits+1)*math.Ln10:
+		return Fixed[P]{}, fmt.Errorf("calculating e^%s: %w", c.String(), ErrOverflow)
+	}
+
+	// Reducing the argument to r = c - k.ln(10), where |r| <= ln(10)/2, so e^c = e^r.10^k.
+	k := int(math.Round(x.float64() / math.Ln10))
+
+	r, rNeg, _ := addSigned(x, c.t.isNeg(), wideLn10.mulUint(uint64(k)), !c.t.isNeg())
+
+	if c.t.isNeg() {
+		k = -k
+	}
+
+	result, overflow := newFixedFromWide[P](expWide(r, rNeg), wideDecimalDigits-k, false)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("calculating e^%s: %w", c.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// Ln returns the natural logarithm of c, rounded to the nearest value with the supported decimal digits
+// (see HalfEven). The error bound is the same of Exp. An error wrapping ErrInvalidOperation is returned
+// for values lesser or equal than zero, and an error wrapping ErrOverflow if the result doesn't fit in the
+// supported integer digits.
+func (c Fixed[P]) Ln() (Fixed[P], error) {
+	m, e10, err := c.log10Split()
+	if err != nil {
+		return Fixed[P]{}, fmt.Errorf("calculating ln(%s): %w", c.String(), err)
+	}
+
+	// ln(c) = ln(m) + e10.ln(10)
+	ln, neg, _ := addSigned(lnWide(m), false, wideLn10.mulUint(uint64(max(e10, -e10))), e10 < 0)
+
+	result, overflow := newFixedFromWide[P](ln, wideDecimalDigits, neg)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("calculating ln(%s): %w", c.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// Log10 returns the base 10 logarithm of c, rounded to the nearest value with the supported decimal digits
+// (see HalfEven). The error bound is the same of Exp, and the logarithm of powers of 10 are exact.
+// An error wrapping ErrInvalidOperation is returned for values lesser or equal than zero, and an error
+// wrapping ErrOverflow if the result doesn't fit in the supported integer digits.
+func (c Fixed[P]) Log10() (Fixed[P], error) {
+	m, e10, err := c.log10Split()
+	if err != nil {
+		return Fixed[P]{}, fmt.Errorf("calculating log10(%s): %w", c.String(), err)
+	}
+
+	// log10(c) = ln(m)/ln(10) + e10
+	log10, neg, _ := addSigned(lnWide(m).div(wideLn10), false, wide{uint64(max(e10, -e10))}, e10 < 0)
+
+	result, overflow := newFixedFromWide[P](log10, wideDecimalDigits, neg)
+	if overflow {
+		return Fixed[P]{}, fmt.Errorf("calculating log10(%s): %w", c.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// log10Split splits a positive c into m.10^e10, where 1 <= m < 10.
+func (c Fixed[P]) log10Split() (wide, int, error) {
+	if c.t.isNeg() || c.t.isZero() {
+		return wide{}, 0, fmt.Errorf("logarithm of non-positive number: %w", ErrInvalidOperation)
+	}
+
+	digits := c.t.abs().digits()
+
+	// Since m has at most naturalMaxLen digits, it's represented exactly.
+	m, _ := newWideFromNatural(c.t.abs(), digits-1)
+
+	return m, digits - 1 - currencyDecimalDigits, nil
+}
+
+// absInt64 returns the absolute value of x as an uint64, which also represents the absolute value of math.MinInt64.
+func absInt64(x int64) uint64 {
+	if x < 0 {
+		return uint64(-x)
+	}
+
+	return uint64(x)
 }
@@ -1,10 +1,18 @@
 package moedinha
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
 	"strconv"
+	"strings"
 	"testing"
+	"unsafe"
 
 	"github.com/mqzabin/fuzzdecimal"
+	"github.com/mqzabin/fuzzdecimal/fdlib"
 	"github.com/shopspring/decimal"
 )
 
@@ -43,13 +51,65 @@ func FuzzUnary(f *testing.F) {
 				return x1.String()
 			},
 		)
+
+		fuzzdecimal.AsDecimalComparison1(t, "Sign", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return strconv.Itoa(x1.Sign()), nil
+			},
+			func(t *fuzzdecimal.T, x1 Currency) string {
+				return strconv.Itoa(x1.Sign())
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison1(t, "Neg", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return x1.Neg().String(), nil
+			},
+			func(t *fuzzdecimal.T, x1 Currency) string {
+				return x1.Neg().String()
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison1(t, "Abs", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return x1.Abs().String(), nil
+			},
+			func(t *fuzzdecimal.T, x1 Currency) string {
+				return x1.Abs().String()
+			},
+		)
+
+		fuzzdecimal.AsDecimal1(t, "CanonicalZero", parseDecimal, func(t *fuzzdecimal.T, x1 Currency) {
+			// Every zero should have the same representation, so it can be compared with ==.
+			for _, result := range []Currency{x1, x1.Neg(), x1.Sub(x1), x1.Neg().Add(x1), x1.Mul(Currency{}), x1.Neg().Mul(Currency{})} {
+				if result.IsZero() != (result == Currency{}) {
+					t.Errorf("%s is not the canonical zero", result.String())
+				}
+			}
+		})
 	}, fuzzdecimal.WithAllDecimals(
 		fuzzdecimal.WithSigned(),
 		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen),
-		fuzzdecimal.WithDecimalPointAt(currencyDecimalDigits),
+		withDecimalPointAt(currencyDecimalDigits),
 	))
 }
 
+// withDecimalPointAt is like fuzzdecimal.WithDecimalPointAt, but generating integers when the position is
+// zero, i.e. when the settings reserve no digit to the decimal places, which fuzzdecimal rejects.
+func withDecimalPointAt(position int) fuzzdecimal.DecimalOption {
+	return func(f *testing.F, cfg *fdlib.DecimalConfig) {
+		f.Helper()
+
+		cfg.DecimalPointPosition = position
+	}
+}
+
 func FuzzComparisons(f *testing.F) {
 	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
 		t.Helper()
@@ -121,10 +181,57 @@ func FuzzComparisons(f *testing.F) {
 	}, fuzzdecimal.WithAllDecimals(
 		fuzzdecimal.WithSigned(),
 		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen),
-		fuzzdecimal.WithDecimalPointAt(currencyDecimalDigits),
+		withDecimalPointAt(currencyDecimalDigits),
 	))
 }
 
+// TestNaturalComparisons covers the equal naturals, which are rarely generated by FuzzComparisons, and the
+// naturals differing by a single uint.
+func TestNaturalComparisons(t *testing.T) {
+	var zero, one, last, first, greatest natural
+
+	one[numberOfUints-1] = 1
+	last[numberOfUints-1] = maxValuePerUint
+	first[0] = 1
+
+	for i := range greatest {
+		greatest[i] = maxValuePerUint
+	}
+
+	type comparison struct {
+		name string
+		n, v natural
+		cmp  int
+	}
+
+	tests := []comparison{
+		{"zeros", zero, zero, 0},
+		{"ones", one, one, 0},
+		{"greatest", greatest, greatest, 0},
+		{"last uint", one, last, -1},
+		{"greatest first uint", first, greatest, -1},
+	}
+
+	if numberOfUints > 1 {
+		// The first uint is the most significant, even when the following ones are greater.
+		tests = append(tests, comparison{"first uint", last, first, -1})
+	}
+
+	for _, test := range tests {
+		for _, operands := range []comparison{test, {test.name, test.v, test.n, -test.cmp}} {
+			n, v, cmp := operands.n, operands.v, operands.cmp
+
+			got := []bool{n.lessThan(v), n.lessThanOrEqual(v), n.greaterThan(v), n.greaterThanOrEqual(v)}
+			want := []bool{cmp < 0, cmp <= 0, cmp > 0, cmp >= 0}
+
+			if n.cmp(v) != cmp || fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("%s: comparing %v to %v: expected cmp %d and %v, got %d and %v",
+					operands.name, n, v, cmp, want, n.cmp(v), got)
+			}
+		}
+	}
+}
+
 func FuzzAddSub(f *testing.F) {
 	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
 		t.Helper()
@@ -165,7 +272,63 @@ func FuzzAddSub(f *testing.F) {
 		// The a+b and a-b operations will at most add 1 digit to the greatest number between a and b.
 		// So, we should ensure that the greatest number has at most naturalMaxLen-1 digits.
 		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen-1),
-		fuzzdecimal.WithDecimalPointAt(currencyDecimalDigits),
+		withDecimalPointAt(currencyDecimalDigits),
+	))
+}
+
+func FuzzRound(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	roundings := []struct {
+		name      string
+		round     func(c Currency, places int) Currency
+		reference func(d decimal.Decimal, places int32) decimal.Decimal
+	}{
+		{"Round/HalfUp", func(c Currency, places int) Currency { return c.Round(places, HalfUp) }, decimal.Decimal.Round},
+		{"Round/HalfEven", func(c Currency, places int) Currency { return c.Round(places, HalfEven) }, decimal.Decimal.RoundBank},
+		{"Round/HalfDown", func(c Currency, places int) Currency { return c.Round(places, HalfDown) }, roundHalfDown},
+		{"Round/Up", func(c Currency, places int) Currency { return c.Round(places, Up) }, decimal.Decimal.RoundUp},
+		{"Round/Down", func(c Currency, places int) Currency { return c.Round(places, Down) }, decimal.Decimal.RoundDown},
+		{"Round/Ceiling", func(c Currency, places int) Currency { return c.Round(places, Ceiling) }, decimal.Decimal.RoundCeil},
+		{"Round/Floor", func(c Currency, places int) Currency { return c.Round(places, Floor) }, decimal.Decimal.RoundFloor},
+		{"Truncate", Currency.Truncate, decimal.Decimal.RoundDown},
+		{"Floor", Currency.Floor, decimal.Decimal.RoundFloor},
+		{"Ceil", Currency.Ceil, decimal.Decimal.RoundCeil},
+	}
+
+	fuzzdecimal.Fuzz(f, 1, func(t *fuzzdecimal.T) {
+		for _, r := range roundings {
+			for _, places := range []int{2, 0, -2} {
+				name := r.name + "/" + strconv.Itoa(places)
+
+				fuzzdecimal.AsDecimalComparison1(t, name, parseDecimal, parseShopspringDecimal,
+					func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+						t.Helper()
+
+						return r.reference(x1, int32(places)).String(), nil
+					},
+					func(t *fuzzdecimal.T, x1 Currency) string {
+						return r.round(x1, places).String()
+					},
+				)
+			}
+		}
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		// Rounding away from zero will at most add 1 digit to the number.
+		// So, we should ensure that the number has at most naturalMaxLen-1 digits.
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen-1),
+		withDecimalPointAt(currencyDecimalDigits),
 	))
 }
 
@@ -193,166 +356,2637 @@ func FuzzMul(f *testing.F) {
 				return x1.Mul(x2).String()
 			},
 		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "MulRound", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				product := x1.Mul(x2)
+				rounded := product.RoundBank(currencyDecimalDigits)
+
+				return rounded.String() + " " + strconv.FormatBool(rounded.Equal(product)), nil
+			},
+			func(t *fuzzdecimal.T, x1 Currency, x2 Currency) string {
+				product, exact := x1.MulRound(x2, HalfEven)
+
+				return product.String() + " " + strconv.FormatBool(exact)
+			},
+		)
 	}, fuzzdecimal.WithAllDecimals(
 		fuzzdecimal.WithSigned(),
 		// Multiplication result will at most sum the number of digits of "a" and "b" in "a*b".
 		// So, we should ensure that digits(a) + digits(b) don't overflow the
 		// naturalMaxLen constant.
 		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen/2),
-		fuzzdecimal.WithDecimalPointAt(currencyDecimalDigits),
+		withDecimalPointAt(min(currencyDecimalDigits, naturalMaxLen/2)),
 	))
 }
 
-func BenchmarkNewFromString(b *testing.B) {
-	aStr := "8901234567890124190123456789012345612345678.9012345678"
+func FuzzMulAdd(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
 
-	var (
-		mCurrency Currency
-		sCurrency decimal.Decimal
-	)
+		return NewFromString(s)
+	}
 
-	b.Run("moedinha", func(b *testing.B) {
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
 
-		for i := 0; i < b.N; i++ {
-			x, _ := NewFromString(aStr)
+		return decimal.NewFromString(s)
+	}
 
-			mCurrency = x
-		}
-	})
+	fuzzdecimal.Fuzz(f, 3, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison3(t, "MulAdd", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2, x3 decimal.Decimal) (string, error) {
+				t.Helper()
 
-	b.Run("shopspring", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			x, _ := decimal.NewFromString(aStr)
+				return x1.Mul(x2).Add(x3).Truncate(currencyDecimalDigits).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2, x3 Currency) string {
+				return x1.MulAdd(x2, x3).String()
+			},
+		)
 
-			sCurrency = x
-		}
-	})
+		fuzzdecimal.AsDecimalComparison3(t, "MulAddRound", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2, x3 decimal.Decimal) (string, error) {
+				t.Helper()
 
-	b.Log(mCurrency.String())
-	b.Log(sCurrency.String())
-}
+				result := x1.Mul(x2).Add(x3)
+				rounded := result.RoundBank(currencyDecimalDigits)
 
-func BenchmarkString(b *testing.B) {
-	aStr := "8901234567890124190123456789012345612345678.9012345678"
+				return rounded.String() + " " + strconv.FormatBool(rounded.Equal(result)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2, x3 Currency) string {
+				result, exact := x1.MulAddRound(x2, x3, HalfEven)
 
-	var (
-		mCurrency string
-		sCurrency string
-	)
+				return result.String() + " " + strconv.FormatBool(exact)
+			},
+		)
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		// The product of "a*b" will at most sum the number of digits of "a" and "b", and the sum with "c"
+		// adds at most one digit. So, we should ensure that digits(a) + digits(b) + 1 don't overflow the
+		// naturalMaxLen constant.
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen/2-1),
+		withDecimalPointAt(min(currencyDecimalDigits, naturalMaxLen/2-1)),
+	))
+}
 
-	b.Run("moedinha", func(b *testing.B) {
-		x, _ := NewFromString(aStr)
+func TestMulAddOverflow(t *testing.T) {
+	tests := []struct{ x, v, a Currency }{
+		{MaxValue(), MaxValue(), MaxValue()},
+		{MinValue(), MaxValue(), MinValue()},
+		{MinValue(), MinValue(), MaxValue()},
+		{MaxValue(), MaxValue(), MinValue()},
+	}
 
-		for i := 0; i < b.N; i++ {
-			mCurrency = x.String()
+	for _, test := range tests {
+		if result, err := test.x.MulAddChecked(test.v, test.a); !errors.Is(err, ErrOverflow) {
+			t.Errorf("calculating %s * %s + %s: expected an overflow error, got %s, %v",
+				test.x.String(), test.v.String(), test.a.String(), result.String(), err)
 		}
-	})
+	}
+}
 
-	b.Run("shopspring", func(b *testing.B) {
-		x, _ := decimal.NewFromString(aStr)
+// testCents is a precision with 16 integer digits and 2 decimal digits.
+type testCents struct{}
 
-		for i := 0; i < b.N; i++ {
+func (testCents) IntegerDigits() int { return 16 }
+func (testCents) DecimalDigits() int { return 2 }
 
-			sCurrency = x.String()
-		}
-	})
+// testUnits is a precision with 17 integer digits and no decimal digits.
+type testUnits struct{}
 
-	b.Log(mCurrency)
-	b.Log(sCurrency)
+func (testUnits) IntegerDigits() int { return 17 }
+func (testUnits) DecimalDigits() int { return 0 }
+
+// testTiny is a precision with 2 integer digits and 1 decimal digit.
+type testTiny struct{}
+
+func (testTiny) IntegerDigits() int { return 2 }
+func (testTiny) DecimalDigits() int { return 1 }
+
+// testUnsupported is a precision with more decimal digits than the supported ones.
+type testUnsupported struct{}
+
+func (testUnsupported) IntegerDigits() int { return 1 }
+func (testUnsupported) DecimalDigits() int { return currencyDecimalDigits + 1 }
+
+func TestUnsupportedPrecision(t *testing.T) {
+	constructors := map[string]func(){
+		"NewFixedFromString": func() { _, _ = NewFixedFromString[testUnsupported]("1") },
+		"MaxFixed":           func() { MaxFixed[testUnsupported]() },
+		"MinFixed":           func() { MinFixed[testUnsupported]() },
+		"Convert":            func() { _, _ = Convert[testUnsupported](Currency{}) },
+		"ConvertRound":       func() { _, _ = ConvertRound[testUnsupported](Currency{}, HalfEven) },
+	}
+
+	for name, constructor := range constructors {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic for an unsupported precision")
+				}
+			}()
+
+			constructor()
+		})
+	}
 }
 
-func BenchmarkAdd(b *testing.B) {
-	aStr := "8901234567890124190123456789012345612345678.9012345678"
-	bStr := "2345678901234567500000000000000000000000000"
+func FuzzFixed(f *testing.F) {
+	if currencyDecimalDigits < (testCents{}).DecimalDigits() || currencyMaxIntegerDigits < (testCents{}).IntegerDigits() {
+		f.Skip("the settings don't support the testCents precision")
+	}
 
-	var (
-		mCurrency Currency
-		sCurrency decimal.Decimal
-	)
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Fixed[testCents], error) {
+		t.Helper()
 
-	b.Run("moedinha", func(b *testing.B) {
-		x, _ := NewFromString(aStr)
+		return NewFixedFromString[testCents](s)
+	}
 
-		y, _ := NewFromString(bStr)
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
 
-		for i := 0; i < b.N; i++ {
-			mCurrency = x.Add(y)
+		return decimal.NewFromString(s)
+	}
+
+	limit := decimal.New(1, int32(testCents{}.IntegerDigits()))
+
+	// checked returns the result string, or the error sentinel message if the result overflows.
+	checked := func(result decimal.Decimal) string {
+		if result.Abs().GreaterThanOrEqual(limit) {
+			return ErrOverflow.Error()
 		}
-	})
 
-	b.Run("shopspring", func(b *testing.B) {
-		x, _ := decimal.NewFromString(aStr)
+		return result.String()
+	}
 
-		y, _ := decimal.NewFromString(bStr)
+	// errorString returns the result string, or the error sentinel message if an error is returned.
+	errorString := func(t *fuzzdecimal.T, result fmt.Stringer, err error) string {
+		t.Helper()
 
-		for i := 0; i < b.N; i++ {
-			sCurrency = x.Add(y)
+		for _, sentinel := range []error{ErrOverflow, ErrDivisionByZero, ErrInexact, ErrInvalidOperation} {
+			if errors.Is(err, sentinel) {
+				return sentinel.Error()
+			}
 		}
-	})
 
-	b.Log(mCurrency.String())
-	b.Log(sCurrency.String())
-}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
 
-func BenchmarkSub(b *testing.B) {
-	aStr := "8901234567890124190123456789012345612345678.9012345678"
-	bStr := "2345678901234567500000000000000000000000000"
+		return result.String()
+	}
 
-	var (
-		mCurrency Currency
-		sCurrency decimal.Decimal
-	)
+	fuzzdecimal.Fuzz(f, 2, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison2(t, "AddChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
 
-	b.Run("moedinha", func(b *testing.B) {
-		x, _ := NewFromString(aStr)
+				return checked(x1.Add(x2)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Fixed[testCents]) string {
+				result, err := x1.AddChecked(x2)
 
-		y, _ := NewFromString(bStr)
+				return errorString(t, result, err)
+			},
+		)
 
-		for i := 0; i < b.N; i++ {
-			mCurrency = x.Sub(y)
-		}
-	})
+		fuzzdecimal.AsDecimalComparison2(t, "SubChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
 
-	b.Run("shopspring", func(b *testing.B) {
-		x, _ := decimal.NewFromString(aStr)
+				return checked(x1.Sub(x2)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Fixed[testCents]) string {
+				result, err := x1.SubChecked(x2)
 
-		y, _ := decimal.NewFromString(bStr)
+				return errorString(t, result, err)
+			},
+		)
 
-		for i := 0; i < b.N; i++ {
-			sCurrency = x.Sub(y)
-		}
-	})
+		fuzzdecimal.AsDecimalComparison2(t, "MulChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
 
-	b.Log(mCurrency.String())
-	b.Log(sCurrency.String())
-}
+				return checked(x1.Mul(x2).Truncate(2)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Fixed[testCents]) string {
+				result, err := x1.MulChecked(x2)
 
-func BenchmarkMul(b *testing.B) {
-	aStr := "10000000000000000010000000000000000010000.00000000000001"
-	bStr := "0.999999999999999999"
+				return errorString(t, result, err)
+			},
+		)
 
-	var (
-		mCurrency Currency
-		sCurrency decimal.Decimal
-	)
+		fuzzdecimal.AsDecimalComparison2(t, "MulRound", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
 
-	b.Run("moedinha", func(b *testing.B) {
-		x, _ := NewFromString(aStr)
+				product := x1.Mul(x2)
+				rounded := product.RoundBank(2)
 
-		y, _ := NewFromString(bStr)
+				if rounded.Abs().GreaterThanOrEqual(limit) {
+					return ErrOverflow.Error(), nil
+				}
 
-		for i := 0; i < b.N; i++ {
-			mCurrency = x.Mul(y)
-		}
-	})
+				return rounded.String() + " " + strconv.FormatBool(rounded.Equal(product)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Fixed[testCents]) string {
+				result, exact, overflow := x1.mulRound(x2, HalfEven)
+				if overflow {
+					return ErrOverflow.Error()
+				}
 
-	b.Run("shopspring", func(b *testing.B) {
-		x, _ := decimal.NewFromString(aStr)
+				return result.String() + " " + strconv.FormatBool(exact)
+			},
+		)
 
-		y, _ := decimal.NewFromString(bStr)
+		fuzzdecimal.AsDecimalComparison2(t, "MulAddRound", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
 
-		for i := 0; i < b.N; i++ {
-			sCurrency = x.Mul(y)
+				return checked(x1.Mul(x2).Add(x1).RoundBank(2)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Fixed[testCents]) string {
+				result, _, overflow := x1.mulAddRound(x2, x1, HalfEven)
+				if overflow {
+					return ErrOverflow.Error()
+				}
+
+				return result.String()
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "DivChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x2.IsZero() {
+					return ErrDivisionByZero.Error(), nil
+				}
+
+				q, _ := x1.QuoRem(x2, 2)
+
+				return checked(q), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Fixed[testCents]) string {
+				result, err := x1.DivChecked(x2)
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "DivRoundChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x2.IsZero() {
+					return ErrDivisionByZero.Error(), nil
+				}
+
+				return checked(x1.DivRound(x2, 2)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Fixed[testCents]) string {
+				// The places greater than the precision decimal digits are handled as the precision ones.
+				result, err := x1.DivRoundChecked(x2, currencyDecimalDigits, HalfUp)
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "ShiftRound", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, _ decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return x1.Shift(-1).RoundBank(2).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, _ Fixed[testCents]) string {
+				result, err := x1.ShiftRound(-1, HalfEven)
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "Sqrt", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, _ decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x1.Sign() < 0 {
+					return ErrInvalidOperation.Error(), nil
+				}
+
+				// Since x1 has 2 decimal digits, sqrt(x1).100 = sqrt(x1.10^4), and there are no ties, since
+				// (2r + 1)^2 is odd. So, the nearest root is (floor(2.sqrt(x1.10^4)) + 1) / 2.
+				n := x1.Shift(4).BigInt()
+				r := n.Sqrt(n.Mul(n, big.NewInt(4)))
+				r.Rsh(r.Add(r, big.NewInt(1)), 1)
+
+				return decimal.NewFromBigInt(r, -2).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, _ Fixed[testCents]) string {
+				result, err := x1.Sqrt()
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "Ln", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, _ decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x1.Sign() <= 0 {
+					return ErrInvalidOperation.Error(), nil
+				}
+
+				return decimal.RequireFromString(lnBigFloat(decimalToBigFloat(x1)).Text('f', 2)).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, _ Fixed[testCents]) string {
+				result, err := x1.Ln()
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "ConvertRound", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, _ decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return x1.RoundBank(0).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, _ Fixed[testCents]) string {
+				result, err := ConvertRound[testUnits](x1, HalfEven)
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "Convert", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				d := x1.Sub(x2)
+
+				switch {
+				case d.Abs().GreaterThanOrEqual(decimal.New(1, int32(testTiny{}.IntegerDigits()))):
+					return ErrOverflow.Error(), nil
+				case !d.Equal(d.Truncate(1)):
+					return ErrInexact.Error(), nil
+				default:
+					return d.String(), nil
+				}
+			},
+			func(t *fuzzdecimal.T, x1, x2 Fixed[testCents]) string {
+				// Converting to the default precision, to avoid the overflow of the testCents precision.
+				c1, err := Convert[DefaultPrecision](x1)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				c2, err := Convert[DefaultPrecision](x2)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				result, err := Convert[testTiny](c1.Sub(c2))
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "NewFixedFromString", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				product := x1.Mul(x2).Truncate(currencyDecimalDigits)
+
+				if !product.Equal(product.Truncate(2)) || product.Abs().GreaterThanOrEqual(limit) {
+					return ErrInvalidFormat.Error(), nil
+				}
+
+				return product.String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Fixed[testCents]) string {
+				c1, _ := Convert[DefaultPrecision](x1)
+				c2, _ := Convert[DefaultPrecision](x2)
+
+				result, err := NewFixedFromString[testCents](c1.Mul(c2).String())
+				if errors.Is(err, ErrInvalidFormat) {
+					return ErrInvalidFormat.Error()
+				}
+
+				return errorString(t, result, err)
+			},
+		)
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		// The testCents digits.
+		fuzzdecimal.WithMaxSignificantDigits(16+2),
+		fuzzdecimal.WithDecimalPointAt(2),
+	))
+}
+
+func FuzzShift(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	limit := decimal.New(1, currencyMaxIntegerDigits)
+
+	fuzzdecimal.Fuzz(f, 1, func(t *fuzzdecimal.T) {
+		for _, n := range []int{0, 1, 2, 17, 19, 54, -1, -2, -17, -19, -54, -72, math.MinInt, math.MaxInt} {
+			// The shifts beyond the supported digits are equivalent to the clamped ones.
+			shift := int32(min(max(n, -naturalMaxLen-1), naturalMaxLen+1))
+
+			fuzzdecimal.AsDecimalComparison1(t, "Shift/"+strconv.Itoa(n), parseDecimal, parseShopspringDecimal,
+				func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+					t.Helper()
+
+					result := x1.Shift(shift)
+
+					switch {
+					case result.Abs().GreaterThanOrEqual(limit):
+						return ErrOverflow.Error(), nil
+					case !result.Equal(result.Truncate(currencyDecimalDigits)):
+						return ErrInexact.Error(), nil
+					}
+
+					return result.String(), nil
+				},
+				func(t *fuzzdecimal.T, x1 Currency) string {
+					result, err := x1.Shift(n)
+					if errors.Is(err, ErrOverflow) {
+						return ErrOverflow.Error()
+					}
+
+					if errors.Is(err, ErrInexact) {
+						return ErrInexact.Error()
+					}
+
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+
+					return result.String()
+				},
+			)
+
+			fuzzdecimal.AsDecimalComparison1(t, "ShiftRound/"+strconv.Itoa(n), parseDecimal, parseShopspringDecimal,
+				func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+					t.Helper()
+
+					result := x1.Shift(shift).RoundBank(currencyDecimalDigits)
+					if result.Abs().GreaterThanOrEqual(limit) {
+						return ErrOverflow.Error(), nil
+					}
+
+					return result.String(), nil
+				},
+				func(t *fuzzdecimal.T, x1 Currency) string {
+					result, err := x1.ShiftRound(n, HalfEven)
+					if errors.Is(err, ErrOverflow) {
+						return ErrOverflow.Error()
+					}
+
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+
+					return result.String()
+				},
+			)
+		}
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen),
+		withDecimalPointAt(currencyDecimalDigits),
+	))
+}
+
+func TestShiftOutOfRange(t *testing.T) {
+	// Using all the digits of the settings, and the smallest positive number as the rounded up result.
+	str, unit := strings.Repeat("1", currencyMaxIntegerDigits), "1"
+	if currencyDecimalDigits > 0 {
+		str += "." + strings.Repeat("1", currencyDecimalDigits)
+		unit = "0." + strings.Repeat("0", currencyDecimalDigits-1) + "1"
+	}
+
+	x, err := NewFromString(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, n := range []int{-naturalMaxLen - 1, -naturalMaxLen - 2, math.MinInt + 1, math.MinInt} {
+		if result, err := x.Shift(n); !errors.Is(err, ErrInexact) {
+			t.Errorf("shifting %s by %d: expected an inexact error, got %s, %v", x.String(), n, result.String(), err)
+		}
+
+		if result, err := x.ShiftRound(n, Up); err != nil || result.String() != unit {
+			t.Errorf("shifting %s by %d rounding up: got %s, %v", x.String(), n, result.String(), err)
+		}
+
+		if result, err := x.ShiftRound(n, HalfUp); err != nil || !result.IsZero() {
+			t.Errorf("shifting %s by %d rounding half up: got %s, %v", x.String(), n, result.String(), err)
+		}
+	}
+}
+
+func FuzzChecked(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	limit := decimal.New(1, currencyMaxIntegerDigits)
+
+	// checked returns the result string, or the error sentinel message if the result overflows.
+	checked := func(result decimal.Decimal) string {
+		if result.Abs().GreaterThanOrEqual(limit) {
+			return ErrOverflow.Error()
+		}
+
+		return result.String()
+	}
+
+	// errorString returns the result string, or the error sentinel message if an error is returned.
+	errorString := func(t *fuzzdecimal.T, result Currency, err error) string {
+		t.Helper()
+
+		for _, sentinel := range []error{ErrOverflow, ErrDivisionByZero} {
+			if errors.Is(err, sentinel) {
+				return sentinel.Error()
+			}
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		return result.String()
+	}
+
+	fuzzdecimal.Fuzz(f, 2, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison2(t, "AddChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return checked(x1.Add(x2)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				result, err := x1.AddChecked(x2)
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "SubChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return checked(x1.Sub(x2)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				result, err := x1.SubChecked(x2)
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "MulChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return checked(x1.Mul(x2).Truncate(currencyDecimalDigits)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				result, err := x1.MulChecked(x2)
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "MulRoundChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return checked(x1.Mul(x2).RoundBank(currencyDecimalDigits)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				result, err := x1.MulRoundChecked(x2, HalfEven)
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "RoundChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, _ decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return checked(x1.Round(2)), nil
+			},
+			func(t *fuzzdecimal.T, x1, _ Currency) string {
+				result, err := x1.RoundChecked(2, HalfUp)
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "MulAddChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return checked(x1.Mul(x2).Add(x1).Truncate(currencyDecimalDigits)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				result, err := x1.MulAddChecked(x2, x1)
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "DivChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x2.IsZero() {
+					return ErrDivisionByZero.Error(), nil
+				}
+
+				q, _ := x1.QuoRem(x2, currencyDecimalDigits)
+
+				return checked(q), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				result, err := x1.DivChecked(x2)
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "QuoRemChecked", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x2.IsZero() {
+					return ErrDivisionByZero.Error(), nil
+				}
+
+				q, r := x1.QuoRem(x2, 0)
+				if q.Abs().GreaterThanOrEqual(limit) {
+					return ErrOverflow.Error(), nil
+				}
+
+				return q.String() + " " + r.String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				q, r, err := x1.QuoRemChecked(x2)
+				if err != nil {
+					return errorString(t, Currency{}, err)
+				}
+
+				return q.String() + " " + r.String()
+			},
+		)
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen),
+		withDecimalPointAt(currencyDecimalDigits),
+	))
+}
+
+func TestCheckedRoundingOverflow(t *testing.T) {
+	// The products of the greatest numbers, with all the decimal digits, overflow regardless of the rounding.
+	for _, mode := range []RoundingMode{HalfEven, Down, Up} {
+		for _, v := range []Currency{MaxValue(), MinValue()} {
+			if result, err := MinValue().MulRoundChecked(v, mode); !errors.Is(err, ErrOverflow) {
+				t.Errorf("MulRoundChecked(%s, %d): expected an overflow error, got %s, %v", v.String(), mode,
+					result.String(), err)
+			}
+
+			if _, err := Calc(MinValue()).MulRound(v, mode).Result(); !errors.Is(err, ErrOverflow) {
+				t.Errorf("Calc MulRound(%s, %d): expected an overflow error, got %v", v.String(), mode, err)
+			}
+		}
+	}
+
+	if currencyDecimalDigits == 0 {
+		return
+	}
+
+	// Rounding up the last decimal digit of the greatest numbers carries out of the integer digits.
+	places := currencyDecimalDigits - 1
+
+	for _, c := range []Currency{MaxValue(), MinValue()} {
+		if result, err := c.RoundChecked(places, Up); !errors.Is(err, ErrOverflow) {
+			t.Errorf("RoundChecked(%s, %d): expected an overflow error, got %s, %v", c.String(), places,
+				result.String(), err)
+		}
+
+		if _, err := Calc(c).Round(places, Up).Result(); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Calc Round(%s, %d): expected an overflow error, got %v", c.String(), places, err)
+		}
+	}
+}
+
+func FuzzContext(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	maxValue := decimal.RequireFromString(MaxValue().String())
+
+	// conditioned returns the result and the conditions raised by an operation, where inexact tells whether
+	// the result was rounded, and rounded whether the operation always raises the Rounded condition.
+	conditioned := func(result decimal.Decimal, inexact, rounded bool) string {
+		var conditions Condition
+
+		if rounded {
+			conditions |= Rounded
+		}
+
+		if inexact {
+			conditions |= Inexact | Rounded
+		}
+
+		if result.Abs().GreaterThan(maxValue) {
+			conditions |= Overflow | Inexact | Rounded
+			result = maxValue.Mul(decimal.NewFromInt(int64(result.Sign())))
+		}
+
+		return result.String() + " " + conditions.String()
+	}
+
+	// rounded returns the exact result rounded to the given places, and the conditions raised by the rounding.
+	rounded := func(exact decimal.Decimal, places int32, alwaysRounded bool) string {
+		result := exact.RoundBank(places)
+
+		return conditioned(result, !result.Equal(exact), alwaysRounded)
+	}
+
+	// contextResult returns the result and the raised conditions of an untrapped context operation.
+	contextResult := func(t *fuzzdecimal.T, operation func(ctx *Context) (Currency, error)) string {
+		t.Helper()
+
+		ctx := Context{Rounding: HalfEven}
+
+		result, err := operation(&ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		return result.String() + " " + ctx.Flags.String()
+	}
+
+	fuzzdecimal.Fuzz(f, 2, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison2(t, "Add", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return rounded(x1.Add(x2), currencyDecimalDigits, false), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				return contextResult(t, func(ctx *Context) (Currency, error) { return ctx.Add(x1, x2) })
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "Sub", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return rounded(x1.Sub(x2), currencyDecimalDigits, false), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				return contextResult(t, func(ctx *Context) (Currency, error) { return ctx.Sub(x1, x2) })
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "Mul", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return rounded(x1.Mul(x2), currencyDecimalDigits, true), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				return contextResult(t, func(ctx *Context) (Currency, error) { return ctx.Mul(x1, x2) })
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "Div", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				switch {
+				case x2.IsZero() && x1.IsZero():
+					return "0 " + InvalidOperation.String(), nil
+				case x2.IsZero():
+					return maxValue.Mul(decimal.NewFromInt(int64(x1.Sign()))).String() + " " + DivisionByZero.String(), nil
+				}
+
+				_, r := x1.QuoRem(x2, currencyDecimalDigits)
+
+				return conditioned(divRoundBank(x1, x2, currencyDecimalDigits), !r.IsZero(), false), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				return contextResult(t, func(ctx *Context) (Currency, error) { return ctx.Div(x1, x2) })
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "Round", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, _ decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return rounded(x1, 2, currencyDecimalDigits > 2), nil
+			},
+			func(t *fuzzdecimal.T, x1, _ Currency) string {
+				return contextResult(t, func(ctx *Context) (Currency, error) { return ctx.Round(x1, 2) })
+			},
+		)
+
+		fuzzdecimal.AsDecimal2(t, "Traps", parseDecimal, func(t *fuzzdecimal.T, x1, x2 Currency) {
+			ctx := Context{Rounding: HalfEven, Traps: Inexact | DivisionByZero | InvalidOperation}
+
+			_, err := ctx.Div(x1, x2)
+
+			switch {
+			case x2.IsZero() && x1.IsZero():
+				if !errors.Is(err, ErrInvalidOperation) || ctx.Flags != InvalidOperation {
+					t.Errorf("expected invalid operation error, got: %v, flags: %s", err, ctx.Flags)
+				}
+			case x2.IsZero():
+				if !errors.Is(err, ErrDivisionByZero) || ctx.Flags != DivisionByZero {
+					t.Errorf("expected division by zero error, got: %v, flags: %s", err, ctx.Flags)
+				}
+			case ctx.Flags&Inexact != 0:
+				if !errors.Is(err, ErrInexact) && !errors.Is(err, ErrOverflow) {
+					t.Errorf("expected inexact error, got: %v, flags: %s", err, ctx.Flags)
+				}
+			case err != nil:
+				t.Errorf("unexpected error: %v, flags: %s", err, ctx.Flags)
+			}
+		})
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen),
+		withDecimalPointAt(currencyDecimalDigits),
+	))
+}
+
+func FuzzCalc(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	limit := decimal.New(1, currencyMaxIntegerDigits)
+
+	// overflows reports whether any of the given results overflows.
+	overflows := func(results ...decimal.Decimal) bool {
+		for _, result := range results {
+			if result.Abs().GreaterThanOrEqual(limit) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// errorString returns the calculation result string, or the error sentinel message if an error is returned.
+	errorString := func(t *fuzzdecimal.T, result Currency, err error) string {
+		t.Helper()
+
+		for _, sentinel := range []error{ErrOverflow, ErrDivisionByZero} {
+			if errors.Is(err, sentinel) {
+				return sentinel.Error()
+			}
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		return result.String()
+	}
+
+	fuzzdecimal.Fuzz(f, 3, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison3(t, "MulAddRound", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2, x3 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				product := x1.Mul(x2).Truncate(currencyDecimalDigits)
+				sum := product.Add(x3)
+				rounded := sum.RoundBank(2)
+
+				if overflows(product, sum, rounded) {
+					return ErrOverflow.Error(), nil
+				}
+
+				return rounded.String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2, x3 Currency) string {
+				result, err := Calc(x1).Mul(x2).Add(x3).Round(2, HalfEven).Result()
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison3(t, "MulAdd", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2, x3 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				sum := x1.Mul(x2).Add(x3).Truncate(currencyDecimalDigits)
+				rounded := sum.RoundBank(2)
+
+				if overflows(sum, rounded) {
+					return ErrOverflow.Error(), nil
+				}
+
+				return rounded.String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2, x3 Currency) string {
+				result, err := Calc(x1).MulAdd(x2, x3).Round(2, HalfEven).Result()
+
+				return errorString(t, result, err)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison3(t, "DivSub", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2, x3 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x2.IsZero() {
+					return ErrDivisionByZero.Error(), nil
+				}
+
+				q, _ := x1.QuoRem(x2, currencyDecimalDigits)
+				diff := q.Sub(x3)
+
+				if overflows(q, diff) {
+					return ErrOverflow.Error(), nil
+				}
+
+				return diff.String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2, x3 Currency) string {
+				result, err := Calc(x1).Div(x2).Sub(x3).Result()
+
+				return errorString(t, result, err)
+			},
+		)
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen),
+		withDecimalPointAt(currencyDecimalDigits),
+	))
+}
+
+func FuzzSaturating(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	maxValue := decimal.RequireFromString(MaxValue().String())
+
+	// saturated returns the result clamped to the MaxValue and MinValue range, and whether it was clamped.
+	saturated := func(result decimal.Decimal) string {
+		if result.Abs().GreaterThan(maxValue) {
+			return maxValue.Mul(decimal.NewFromInt(int64(result.Sign()))).String() + " true"
+		}
+
+		return result.String() + " false"
+	}
+
+	fuzzdecimal.Fuzz(f, 2, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison2(t, "SaturatingAdd", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return saturated(x1.Add(x2)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				result, clamped := x1.SaturatingAdd(x2)
+
+				return result.String() + " " + strconv.FormatBool(clamped)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "SaturatingSub", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return saturated(x1.Sub(x2)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				result, clamped := x1.SaturatingSub(x2)
+
+				return result.String() + " " + strconv.FormatBool(clamped)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "SaturatingMul", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return saturated(x1.Mul(x2).Truncate(currencyDecimalDigits)), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				result, clamped := x1.SaturatingMul(x2)
+
+				return result.String() + " " + strconv.FormatBool(clamped)
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "SaturatingDiv", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x2.IsZero() {
+					t.Skip("division by zero")
+				}
+
+				q, _ := x1.QuoRem(x2, currencyDecimalDigits)
+
+				return saturated(q), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				result, clamped := x1.SaturatingDiv(x2)
+
+				return result.String() + " " + strconv.FormatBool(clamped)
+			},
+		)
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen),
+		withDecimalPointAt(currencyDecimalDigits),
+	))
+}
+
+func FuzzInt64(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	// The second decimal is used as an int64, so its decimal digits are discarded, and values that don't fit in
+	// an int64 are skipped.
+	toInt64 := func(t *fuzzdecimal.T, x decimal.Decimal) int64 {
+		t.Helper()
+
+		if !x.Truncate(0).Equal(decimal.NewFromInt(x.IntPart())) {
+			t.Skip("value doesn't fit in an int64")
+		}
+
+		return x.IntPart()
+	}
+
+	currencyToInt64 := func(t *fuzzdecimal.T, x Currency) int64 {
+		t.Helper()
+
+		return toInt64(t, decimal.RequireFromString(x.String()))
+	}
+
+	fuzzdecimal.Fuzz(f, 2, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison2(t, "AddInt64", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return x1.Add(decimal.NewFromInt(toInt64(t, x2))).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				return x1.AddInt64(currencyToInt64(t, x2)).String()
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "MulInt64", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return x1.Mul(decimal.NewFromInt(toInt64(t, x2))).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				return x1.MulInt64(currencyToInt64(t, x2)).String()
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "MulUint64", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return x1.Mul(decimal.NewFromInt(toInt64(t, x2)).Abs()).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				return x1.MulUint64(absInt64(currencyToInt64(t, x2))).String()
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "DivInt64", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				d := toInt64(t, x2)
+				if d == 0 {
+					t.Skip("division by zero")
+				}
+
+				q, _ := x1.QuoRem(decimal.NewFromInt(d), currencyDecimalDigits)
+
+				return q.String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				return x1.DivInt64(currencyToInt64(t, x2)).String()
+			},
+		)
+	},
+		fuzzdecimal.WithDecimal(1,
+			fuzzdecimal.WithSigned(),
+			// The product by an int64 will at most add 19 integer digits to the result. With a single uint,
+			// there is no digit left, so a single digit is used.
+			fuzzdecimal.WithMaxSignificantDigits(max(naturalMaxLen-19, 1)),
+			withDecimalPointAt(min(currencyDecimalDigits, max(naturalMaxLen-19, 1))),
+		),
+		fuzzdecimal.WithDecimal(2,
+			fuzzdecimal.WithSigned(),
+			fuzzdecimal.WithMaxSignificantDigits(20),
+			withDecimalPointAt(min(currencyDecimalDigits, 1)),
+		),
+	)
+}
+
+func TestAddInt64Borrow(t *testing.T) {
+	// Adding the greatest power of ten that fits in an int64 to MinValue, where the power of ten itself doesn't
+	// fit in a Currency with up to maxDigitsPerUint integer digits, even though the sum does.
+	digits := min(currencyMaxIntegerDigits, maxDigitsPerUint)
+
+	power := "1" + strings.Repeat("0", digits)
+
+	want := "0." + strings.Repeat("0", max(currencyDecimalDigits-1, 0)) + "1"
+	if currencyDecimalDigits == 0 {
+		want = "1"
+	}
+
+	if digits < currencyMaxIntegerDigits {
+		p, err := NewFromString(power)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want = MinValue().Add(p).String()
+	}
+
+	if result := MinValue().AddInt64(int64(pow10[digits])); result.String() != want {
+		t.Errorf("calculating %s + %s: expected %s, got %s", MinValue().String(), power, want, result.String())
+	}
+}
+
+func FuzzPow(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	const maxExp = 5
+
+	fuzzdecimal.Fuzz(f, 1, func(t *fuzzdecimal.T) {
+		for _, exp := range []int{0, 1, 2, 3, maxExp, -1, -2} {
+			fuzzdecimal.AsDecimalComparison1(t, "Pow/"+strconv.Itoa(exp), parseDecimal, parseShopspringDecimal,
+				func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+					t.Helper()
+
+					if x1.IsZero() && exp < 0 {
+						return ErrDivisionByZero.Error(), nil
+					}
+
+					if exp < 0 {
+						return powNegativeExact(x1, exp).String(), nil
+					}
+
+					return powBank(x1, exp).String(), nil
+				},
+				func(t *fuzzdecimal.T, x1 Currency) string {
+					result, err := x1.Pow(exp)
+					if errors.Is(err, ErrDivisionByZero) {
+						return ErrDivisionByZero.Error()
+					}
+
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+
+					return result.String()
+				},
+			)
+		}
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		// The a^n operation will at most multiply by n the number of integer digits of "a".
+		// So, we should ensure that the integer digits of "a" times maxExp don't overflow
+		// the currencyMaxIntegerDigits constant.
+		fuzzdecimal.WithMaxSignificantDigits(currencyMaxIntegerDigits/maxExp+currencyDecimalDigits),
+		withDecimalPointAt(currencyDecimalDigits),
+	))
+}
+
+func FuzzRoot(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	// Half unit of the last decimal digit.
+	half := decimal.New(5, -currencyDecimalDigits-1)
+
+	fuzzdecimal.Fuzz(f, 1, func(t *fuzzdecimal.T) {
+		for _, degree := range []int{2, 3, 12} {
+			fuzzdecimal.AsDecimal1(t, "Root/"+strconv.Itoa(degree), parseDecimal, func(t *fuzzdecimal.T, x1 Currency) {
+				result, err := x1.Root(degree)
+
+				if x1.LessThan(Currency{}) && degree%2 == 0 {
+					if !errors.Is(err, ErrInvalidOperation) {
+						t.Fatalf("expected invalid operation error, got: %v", err)
+					}
+
+					return
+				}
+
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				// Since the root is correctly rounded, x1 should be between (r - half)^n and (r + half)^n.
+				x := decimal.RequireFromString(x1.String()).Abs()
+				r := decimal.RequireFromString(result.String()).Abs()
+
+				lower, _ := decimal.Max(r.Sub(half), decimal.Zero).PowInt32(int32(degree))
+				upper, _ := r.Add(half).PowInt32(int32(degree))
+
+				if x.LessThan(lower) || x.GreaterThan(upper) {
+					t.Errorf("%s is not the rounded %d-th root of %s", result.String(), degree, x1.String())
+				}
+			})
+		}
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen),
+		withDecimalPointAt(currencyDecimalDigits),
+	))
+}
+
+// roundHalfDown rounds x to the given places towards the nearest neighbour, or towards zero if both
+// neighbours are equidistant, since shopspring/decimal has no half-down rounding.
+func roundHalfDown(x decimal.Decimal, places int32) decimal.Decimal {
+	truncated := x.RoundDown(places)
+
+	half := decimal.New(5, -places-1)
+
+	if x.Sub(truncated).Abs().GreaterThan(half) {
+		return x.RoundUp(places)
+	}
+
+	return truncated
+}
+
+// powBank calculates x^n for a non-negative n with the same exponentiation by squaring used by
+// Currency.Pow, rounding every intermediate result half-even to currencyDecimalDigits decimal digits.
+func powBank(x decimal.Decimal, n int) decimal.Decimal {
+	square, result := x, decimal.NewFromInt(1)
+
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = result.Mul(square).RoundBank(currencyDecimalDigits)
+		}
+
+		if n > 1 {
+			square = square.Mul(square).RoundBank(currencyDecimalDigits)
+		}
+	}
+
+	return result
+}
+
+// powNegativeExact calculates x^n for a non-zero x and a negative n as the exact power of x divided a
+// single time, rounding the result half-even to currencyDecimalDigits decimal digits.
+func powNegativeExact(x decimal.Decimal, n int) decimal.Decimal {
+	k := int64(-n)
+
+	// Since x = coefficient.10^exponent, x^n.10^currencyDecimalDigits = 10^(currencyDecimalDigits-exponent.k) / coefficient^k.
+	num := big.NewInt(1)
+	den := new(big.Int).Exp(new(big.Int).Abs(x.Coefficient()), big.NewInt(k), nil)
+
+	if scale := currencyDecimalDigits - int64(x.Exponent())*k; scale >= 0 {
+		num.Exp(big.NewInt(base), big.NewInt(scale), nil)
+	} else {
+		den.Mul(den, new(big.Int).Exp(big.NewInt(base), big.NewInt(-scale), nil))
+	}
+
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+
+	if half := r.Lsh(r, 1).Cmp(den); half > 0 || (half == 0 && q.Bit(0) == 1) {
+		q.Add(q, big.NewInt(1))
+	}
+
+	if x.Sign() < 0 && k%2 == 1 {
+		q.Neg(q)
+	}
+
+	return decimal.NewFromBigInt(q, -currencyDecimalDigits)
+}
+
+func TestPowNegative(t *testing.T) {
+	tests := []struct {
+		x string
+		n int
+	}{
+		{"0.7", -100},
+		{"0.000000003", -2},
+		{"-1.1", -33},
+		{"123.456", -3},
+		{"0.999999999999999999", -1000},
+		{"1.000000000000000001", -1000},
+		{"2", -19},
+		{"-2", -61},
+		{"10", -19},
+		{"0.1", -53},
+		{"0.1", -54},
+		{"3", math.MinInt},
+	}
+
+	for _, test := range tests {
+		x, err := NewFromString(test.x)
+		if err != nil {
+			// The number doesn't fit in the settings.go configuration.
+			continue
+		}
+
+		result, err := x.Pow(test.n)
+
+		// Like 3^-1000, 3^math.MinInt is rounded to zero.
+		expected := powNegativeExact(decimal.RequireFromString(x.String()), max(test.n, -1000))
+		if expected.Abs().GreaterThanOrEqual(decimal.New(1, currencyMaxIntegerDigits)) {
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("%s^%d: expected an overflow error, got %s, %v", test.x, test.n, result.String(), err)
+			}
+
+			continue
+		}
+
+		if err != nil || result.String() != expected.String() {
+			t.Errorf("%s^%d: expected %s, got %s, %v", test.x, test.n, expected.String(), result.String(), err)
+		}
+	}
+}
+
+// divRoundBank calculates x/y rounded half-even to the given decimal places.
+func divRoundBank(x, y decimal.Decimal, places int32) decimal.Decimal {
+	q, r := x.QuoRem(y, places)
+
+	unit := decimal.New(1, -places)
+
+	half := r.Abs().Mul(decimal.NewFromInt(2)).Cmp(y.Abs().Mul(unit))
+	odd := !q.Shift(places).Mod(decimal.NewFromInt(2)).IsZero()
+
+	if half > 0 || (half == 0 && odd) {
+		return q.Add(unit.Mul(decimal.NewFromInt(int64(x.Sign() * y.Sign()))))
+	}
+
+	return q
+}
+
+func FuzzQuoRem(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	fuzzdecimal.Fuzz(f, 2, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison2(t, "QuoRem", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x2.IsZero() {
+					t.Skip("division by zero")
+				}
+
+				q, r := x1.QuoRem(x2, 0)
+
+				return q.String() + " " + r.String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				q, r := x1.QuoRem(x2)
+
+				return q.String() + " " + r.String()
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "Mod", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x2.IsZero() {
+					t.Skip("division by zero")
+				}
+
+				return x1.Mod(x2).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				return x1.Mod(x2).String()
+			},
+		)
+
+		fuzzdecimal.AsDecimal2(t, "QuoRemInvariant", parseDecimal, func(t *fuzzdecimal.T, x1, x2 Currency) {
+			if x2.IsZero() {
+				t.Skip("division by zero")
+			}
+
+			q, r := x1.QuoRem(x2)
+
+			if got := q.Mul(x2).Add(r); !got.Equal(x1) {
+				t.Errorf("q*v + r = %s, want %s (q = %s, r = %s)", got.String(), x1.String(), q.String(), r.String())
+			}
+		})
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		// The integer quotient will at most have digits(a) integer digits plus the decimal
+		// digits of "b" in "a/b". So, we should ensure that the quotient don't overflow the
+		// naturalMaxLen constant.
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen/2),
+		withDecimalPointAt(min(currencyDecimalDigits, naturalMaxLen/2)),
+	))
+}
+
+// divPlaces is the decimal places of the rounded divisions checked by the fuzz tests, limited to the supported
+// decimal digits.
+const divPlaces = min(2, currencyDecimalDigits)
+
+func FuzzDiv(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	fuzzdecimal.Fuzz(f, 2, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison2(t, "Div", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x2.IsZero() {
+					t.Skip("division by zero")
+				}
+
+				q, _ := x1.QuoRem(x2, currencyDecimalDigits)
+
+				return q.String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				return x1.Div(x2).String()
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison2(t, "DivRound", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x2.IsZero() {
+					t.Skip("division by zero")
+				}
+
+				return x1.DivRound(x2, divPlaces).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2 Currency) string {
+				return x1.DivRound(x2, divPlaces, HalfUp).String()
+			},
+		)
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		// Division result will at most have digits(a) integer digits plus the decimal digits
+		// of "b" in "a/b". So, we should ensure that the quotient don't overflow the
+		// naturalMaxLen constant.
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen/2),
+		withDecimalPointAt(min(currencyDecimalDigits, naturalMaxLen/2)),
+	))
+}
+
+func FuzzMulDiv(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	fuzzdecimal.Fuzz(f, 3, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison3(t, "MulDiv", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2, x3 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x3.IsZero() {
+					t.Skip("division by zero")
+				}
+
+				q, _ := x1.Mul(x2).QuoRem(x3, currencyDecimalDigits)
+
+				return q.String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2, x3 Currency) string {
+				return x1.MulDiv(x2, x3).String()
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison3(t, "MulDivRound", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1, x2, x3 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x3.IsZero() {
+					t.Skip("division by zero")
+				}
+
+				return x1.Mul(x2).DivRound(x3, divPlaces).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1, x2, x3 Currency) string {
+				return x1.MulDivRound(x2, x3, divPlaces, HalfUp).String()
+			},
+		)
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		// The double-width product of "a*b" can't overflow, and the division by "c" at most
+		// adds the decimal digits of "c" to the quotient. So, we should ensure that
+		// digits(a) + digits(b) don't overflow the naturalMaxLen constant.
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen/2),
+		withDecimalPointAt(min(currencyDecimalDigits, naturalMaxLen/2)),
+	))
+}
+
+func FuzzExp(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	limit := decimal.New(1, currencyMaxIntegerDigits)
+
+	fuzzdecimal.Fuzz(f, 1, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison1(t, "Exp", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				result := bigFloatToDecimal(expBigFloat(decimalToBigFloat(x1)))
+				if result.GreaterThanOrEqual(limit) {
+					return ErrOverflow.Error(), nil
+				}
+
+				return result.String(), nil
+			},
+			func(t *fuzzdecimal.T, x1 Currency) string {
+				result, err := x1.Exp()
+				if errors.Is(err, ErrOverflow) {
+					return ErrOverflow.Error()
+				}
+
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return result.String()
+			},
+		)
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		// e^x overflows the currencyMaxIntegerDigits constant for x > 125, and is rounded to zero for
+		// x < -42. So, three integer digits are enough to reach both limits.
+		fuzzdecimal.WithMaxSignificantDigits(3+currencyDecimalDigits),
+		withDecimalPointAt(currencyDecimalDigits),
+	))
+}
+
+func FuzzLn(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	ln10 := lnBigFloat(new(big.Float).SetPrec(bigFloatPrecision).SetInt64(10))
+
+	fuzzdecimal.Fuzz(f, 1, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison1(t, "Ln", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x1.Sign() <= 0 {
+					return ErrInvalidOperation.Error(), nil
+				}
+
+				return bigFloatToDecimal(lnBigFloat(decimalToBigFloat(x1))).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1 Currency) string {
+				result, err := x1.Ln()
+				if errors.Is(err, ErrInvalidOperation) {
+					return ErrInvalidOperation.Error()
+				}
+
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return result.String()
+			},
+		)
+
+		fuzzdecimal.AsDecimalComparison1(t, "Log10", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				if x1.Sign() <= 0 {
+					return ErrInvalidOperation.Error(), nil
+				}
+
+				ln := lnBigFloat(decimalToBigFloat(x1))
+
+				return bigFloatToDecimal(ln.Quo(ln, ln10)).String(), nil
+			},
+			func(t *fuzzdecimal.T, x1 Currency) string {
+				result, err := x1.Log10()
+				if errors.Is(err, ErrInvalidOperation) {
+					return ErrInvalidOperation.Error()
+				}
+
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return result.String()
+			},
+		)
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen),
+		withDecimalPointAt(currencyDecimalDigits),
+	))
+}
+
+// bigFloatPrecision is the mantissa bits used by the reference implementations of Exp and Ln,
+// which is around 150 decimal digits.
+const bigFloatPrecision = 512
+
+func decimalToBigFloat(x decimal.Decimal) *big.Float {
+	f, _, err := big.ParseFloat(x.String(), 10, bigFloatPrecision, big.ToNearestEven)
+	if err != nil {
+		panic(err)
+	}
+
+	return f
+}
+
+// bigFloatToDecimal rounds x to the nearest decimal with currencyDecimalDigits decimal digits.
+func bigFloatToDecimal(x *big.Float) decimal.Decimal {
+	return decimal.RequireFromString(x.Text('f', currencyDecimalDigits))
+}
+
+// expBigFloat calculates e^x by the Taylor series of x/2^16, squared 16 times.
+func expBigFloat(x *big.Float) *big.Float {
+	const halvings = 16
+
+	s := new(big.Float).SetPrec(bigFloatPrecision).SetMantExp(x, -halvings)
+
+	sum := new(big.Float).SetPrec(bigFloatPrecision).SetInt64(1)
+	term := new(big.Float).SetPrec(bigFloatPrecision).SetInt64(1)
+
+	for i := int64(1); term.Sign() != 0 && term.MantExp(nil) > -2*bigFloatPrecision; i++ {
+		term.Mul(term, s)
+		term.Quo(term, new(big.Float).SetInt64(i))
+		sum.Add(sum, term)
+	}
+
+	for i := 0; i < halvings; i++ {
+		sum.Mul(sum, sum)
+	}
+
+	return sum
+}
+
+// lnBigFloat calculates the natural logarithm of a positive x with the Halley's method over expBigFloat.
+func lnBigFloat(x *big.Float) *big.Float {
+	xf, _ := x.Float64()
+
+	y := new(big.Float).SetPrec(bigFloatPrecision).SetFloat64(math.Log(xf))
+
+	for i := 0; i < 6; i++ {
+		// y' = y + 2.(x - e^y) / (x + e^y)
+		e := expBigFloat(y)
+
+		num := new(big.Float).SetPrec(bigFloatPrecision).Sub(x, e)
+		den := new(big.Float).SetPrec(bigFloatPrecision).Add(x, e)
+
+		num.Mul(num, big.NewFloat(2))
+		y.Add(y, num.Quo(num, den))
+	}
+
+	return y
+}
+
+func FuzzFormat(f *testing.F) {
+	parseDecimal := func(t *fuzzdecimal.T, s string) (Currency, error) {
+		t.Helper()
+
+		return NewFromString(s)
+	}
+
+	parseShopspringDecimal := func(t *fuzzdecimal.T, s string) (decimal.Decimal, error) {
+		t.Helper()
+
+		return decimal.NewFromString(s)
+	}
+
+	roundings := []struct {
+		mode      RoundingMode
+		reference func(d decimal.Decimal, places int32) decimal.Decimal
+	}{
+		{HalfUp, decimal.Decimal.Round},
+		{HalfEven, decimal.Decimal.RoundBank},
+		{Up, decimal.Decimal.RoundUp},
+		{Down, decimal.Decimal.RoundDown},
+		{Ceiling, decimal.Decimal.RoundCeil},
+		{Floor, decimal.Decimal.RoundFloor},
+	}
+
+	// padded pads s with zeros after the sign, or with spaces before it, to the given width.
+	padded := func(s string, width int, pad byte) string {
+		padding := strings.Repeat(string(pad), max(width-len(s), 0))
+
+		if pad == '0' && strings.HasPrefix(s, "-") {
+			return "-" + padding + s[1:]
+		}
+
+		return padding + s
+	}
+
+	fuzzdecimal.Fuzz(f, 1, func(t *fuzzdecimal.T) {
+		fuzzdecimal.AsDecimalComparison1(t, "AppendText", parseDecimal, parseShopspringDecimal,
+			func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+				t.Helper()
+
+				return x1.String(), nil
+			},
+			func(t *fuzzdecimal.T, x1 Currency) string {
+				text, _ := x1.AppendText([]byte("prefix:"))
+
+				return strings.TrimPrefix(string(text), "prefix:")
+			},
+		)
+
+		for _, r := range roundings {
+			for _, places := range []int{2, 0, currencyDecimalDigits + 2} {
+				for _, pad := range []byte{' ', '0'} {
+					format := Format{Decimals: places, Rounding: r.mode, Width: naturalMaxLen, Pad: pad}
+					name := fmt.Sprintf("AppendFormat/%+v", format)
+
+					fuzzdecimal.AsDecimalComparison1(t, name, parseDecimal, parseShopspringDecimal,
+						func(t *fuzzdecimal.T, x1 decimal.Decimal) (string, error) {
+							t.Helper()
+
+							s := r.reference(x1, int32(places)).StringFixed(int32(places))
+
+							return padded(s, format.Width, pad), nil
+						},
+						func(t *fuzzdecimal.T, x1 Currency) string {
+							return string(x1.AppendFormat(nil, format))
+						},
+					)
+				}
+			}
+		}
+	}, fuzzdecimal.WithAllDecimals(
+		fuzzdecimal.WithSigned(),
+		fuzzdecimal.WithMaxSignificantDigits(naturalMaxLen),
+		withDecimalPointAt(currencyDecimalDigits),
+	))
+}
+
+func FuzzParse(f *testing.F) {
+	greatest := strings.Repeat("9", currencyMaxIntegerDigits) + "." + strings.Repeat("9", currencyDecimalDigits)
+
+	for _, s := range []string{
+		"0", "-0", "1", "-1", "1.", "-1.", ".5", "-.5", "1.5", "-1.5", "00.00", "1.2.3", "", "-", ".", "+1",
+		"--1", "1e3", " 1", "1 ", "\u0661", greatest, "-" + greatest, greatest + "9", "9" + greatest,
+	} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		expected, expectedErr := regexpNewFromString(s)
+
+		for name, parse := range map[string]func(string) (Currency, error){
+			"NewFromString": NewFromString,
+			"NewFromBytes":  func(s string) (Currency, error) { return NewFromBytes([]byte(s)) },
+		} {
+			c, err := parse(s)
+
+			if expectedErr != nil {
+				if err == nil || err.Error() != expectedErr.Error() || !errors.Is(err, ErrInvalidFormat) {
+					t.Errorf("%s(%q): expected error %q, got %v", name, s, expectedErr, err)
+				}
+
+				continue
+			}
+
+			if err != nil {
+				t.Errorf("%s(%q): unexpected error: %v", name, s, err)
+
+				continue
+			}
+
+			if c != expected {
+				t.Errorf("%s(%q): expected %s, got %s", name, s, expected.String(), c.String())
+			}
+		}
+	})
+}
+
+// regexpCurrency is the grammar accepted by NewFromString.
+var regexpCurrency = regexp.MustCompile(fmt.Sprintf(
+	`^-?\d{1,%d}(\%c\d{0,%d})?$`,
+	currencyMaxIntegerDigits,
+	currencyDecimalSeparatorSymbol,
+	currencyDecimalDigits,
+))
+
+// regexpNewFromString is the reference implementation of NewFromString, validating the
+// string with regexpCurrency and decoding it by fixed-size chunks.
+func regexpNewFromString(str string) (Currency, error) {
+	if !regexpCurrency.MatchString(str) {
+		return Currency{}, fmt.Errorf(`validating currency: "%s": %w`, str, ErrInvalidFormat)
+	}
+
+	separatorIndex := strings.IndexRune(str, currencyDecimalSeparatorSymbol)
+
+	strLen := len(str)
+
+	decimalDigits := 0
+	integerDigits := strLen
+	if separatorIndex >= 0 {
+		decimalDigits = strLen - (separatorIndex + 1)
+		integerDigits -= decimalDigits + 1
+	}
+
+	// How much the copy should shift in integer number string. For example:
+	// 0.1 is shifted to left by 17 digits if the support is for 18 digits.
+	cpRightShift := currencyDecimalDigits - decimalDigits
+	cpLeftShift := integerMaxLen - cpRightShift - (decimalDigits + integerDigits)
+
+	intString := [integerMaxLen]byte{zeroRune}
+
+	// Copy the integer part.
+	copy(intString[cpLeftShift:cpLeftShift+integerDigits], str[:integerDigits])
+	// Copying the decimal part, if any.
+	if decimalDigits > 0 {
+		copy(intString[cpLeftShift+integerDigits:cpLeftShift+integerDigits+decimalDigits], str[integerDigits+1:])
+	}
+
+	// Adding leading zeros.
+	copy(intString[:cpLeftShift], zeroFiller[:])
+	// Adding trailing zeros.
+	copy(intString[currencyMaxLen-(cpRightShift+1):], zeroFiller[:])
+
+	if cpLeftShift != 0 && intString[cpLeftShift] == integerNegativeSymbol {
+		intString[0] = integerNegativeSymbol
+		intString[cpLeftShift] = zeroRune
+	}
+
+	intValue, err := regexpIntegerFromString(intString)
+	if err != nil {
+		return Currency{}, fmt.Errorf("creating underlying integer: %w", err)
+	}
+
+	return Currency{t: intValue}, nil
+}
+
+func regexpIntegerFromString(str [integerMaxLen]byte) (integer, error) {
+	var neg bool
+	if str[0] == integerNegativeSymbol {
+		neg = true
+	}
+
+	var natStr [naturalMaxLen]byte
+
+	copy(natStr[:], str[1:])
+
+	n, err := regexpNatFromString(natStr)
+	if err != nil {
+		return integer{}, fmt.Errorf("creating underlyin natural number from string: %w", err)
+	}
+
+	return newInteger(n, neg), nil
+}
+
+func regexpNatFromString(v [naturalMaxLen]byte) (natural, error) {
+	var n natural
+
+	for i := 0; i < numberOfUints; i++ {
+		var str [maxDigitsPerUint]byte
+		copy(str[:], v[i*maxDigitsPerUint:(i+1)*maxDigitsPerUint])
+
+		c, err := regexpAtoi(str)
+		if err != nil {
+			return natural{}, fmt.Errorf("error decoding natural number: %w", err)
+		}
+
+		n[i] = c
+	}
+
+	return n, nil
+}
+
+// atoi is a fork from strconv.Atoi with proper signature.
+func regexpAtoi(s [maxDigitsPerUint]byte) (uint64, error) {
+	var n uint64
+	for _, ch := range s {
+		ch -= zeroRune
+		if ch > base-1 {
+			return 0, fmt.Errorf("invalid syntax converting string to uint64: rune %c", ch)
+		}
+		n = n*base + uint64(ch)
+	}
+
+	return n, nil
+}
+
+func BenchmarkNewFromString(b *testing.B) {
+	aStr := "8901234567890124190123456789012345612345678.9012345678"
+
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+
+		for i := 0; i < b.N; i++ {
+			x, _ := NewFromString(aStr)
+
+			mCurrency = x
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x, _ := decimal.NewFromString(aStr)
+
+			sCurrency = x
+		}
+	})
+
+	b.Log(mCurrency.String())
+	b.Log(sCurrency.String())
+}
+
+func BenchmarkString(b *testing.B) {
+	aStr := "8901234567890124190123456789012345612345678.9012345678"
+
+	var (
+		mCurrency string
+		sCurrency string
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.String()
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		x, _ := decimal.NewFromString(aStr)
+
+		for i := 0; i < b.N; i++ {
+
+			sCurrency = x.String()
+		}
+	})
+
+	b.Log(mCurrency)
+	b.Log(sCurrency)
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	aStr := "8901234567890124190123456789012345612345678.9012345678"
+
+	var (
+		mBuf = make([]byte, 0, currencyMaxLen)
+		sBuf = make([]byte, 0, currencyMaxLen)
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		for i := 0; i < b.N; i++ {
+			mBuf, _ = x.AppendText(mBuf[:0])
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		x, _ := decimal.NewFromString(aStr)
+
+		for i := 0; i < b.N; i++ {
+			sBuf = append(sBuf[:0], x.String()...)
+		}
+	})
+
+	b.Log(string(mBuf))
+	b.Log(string(sBuf))
+}
+
+func BenchmarkAdd(b *testing.B) {
+	aStr := "8901234567890124190123456789012345612345678.9012345678"
+	bStr := "2345678901234567500000000000000000000000000"
+
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		y, _ := NewFromString(bStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.Add(y)
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		x, _ := decimal.NewFromString(aStr)
+
+		y, _ := decimal.NewFromString(bStr)
+
+		for i := 0; i < b.N; i++ {
+			sCurrency = x.Add(y)
+		}
+	})
+
+	b.Log(mCurrency.String())
+	b.Log(sCurrency.String())
+}
+
+func BenchmarkSub(b *testing.B) {
+	aStr := "8901234567890124190123456789012345612345678.9012345678"
+	bStr := "2345678901234567500000000000000000000000000"
+
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		y, _ := NewFromString(bStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.Sub(y)
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		x, _ := decimal.NewFromString(aStr)
+
+		y, _ := decimal.NewFromString(bStr)
+
+		for i := 0; i < b.N; i++ {
+			sCurrency = x.Sub(y)
+		}
+	})
+
+	b.Log(mCurrency.String())
+	b.Log(sCurrency.String())
+}
+
+func BenchmarkMul(b *testing.B) {
+	aStr := "10000000000000000010000000000000000010000.00000000000001"
+	bStr := "0.999999999999999999"
+
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		y, _ := NewFromString(bStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.Mul(y)
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		x, _ := decimal.NewFromString(aStr)
+
+		y, _ := decimal.NewFromString(bStr)
+
+		for i := 0; i < b.N; i++ {
+			sCurrency = x.Mul(y)
+		}
+	})
+
+	b.Log(mCurrency.String())
+	b.Log(sCurrency.String())
+}
+
+func BenchmarkMulAdd(b *testing.B) {
+	aStr := "10000000000000000010000000000000000010000.00000000000001"
+	bStr := "0.999999999999999999"
+	cStr := "123456789.123456789"
+
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		y, _ := NewFromString(bStr)
+
+		z, _ := NewFromString(cStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.MulAdd(y, z)
+		}
+	})
+
+	b.Run("moedinha-Mul-Add", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		y, _ := NewFromString(bStr)
+
+		z, _ := NewFromString(cStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.Mul(y).Add(z)
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		x, _ := decimal.NewFromString(aStr)
+
+		y, _ := decimal.NewFromString(bStr)
+
+		z, _ := decimal.NewFromString(cStr)
+
+		for i := 0; i < b.N; i++ {
+			sCurrency = x.Mul(y).Add(z)
+		}
+	})
+
+	b.Log(mCurrency.String())
+	b.Log(sCurrency.String())
+}
+
+func BenchmarkMulInt64(b *testing.B) {
+	aStr := "10000000000000000010000000000000000010000.00000000000001"
+	bInt := int64(123456789)
+
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.MulInt64(bInt)
+		}
+	})
+
+	b.Run("moedinha-Mul", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		y, _ := NewFromString(strconv.FormatInt(bInt, 10))
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.Mul(y)
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		x, _ := decimal.NewFromString(aStr)
+
+		y := decimal.NewFromInt(bInt)
+
+		for i := 0; i < b.N; i++ {
+			sCurrency = x.Mul(y)
+		}
+	})
+
+	b.Log(mCurrency.String())
+	b.Log(sCurrency.String())
+}
+
+func BenchmarkAddInt64(b *testing.B) {
+	aStr := "10000000000000000010000000000000000010000.00000000000001"
+	bInt := int64(123456789)
+
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.AddInt64(bInt)
+		}
+	})
+
+	b.Run("moedinha-Add", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		y, _ := NewFromString(strconv.FormatInt(bInt, 10))
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.Add(y)
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		x, _ := decimal.NewFromString(aStr)
+
+		y := decimal.NewFromInt(bInt)
+
+		for i := 0; i < b.N; i++ {
+			sCurrency = x.Add(y)
+		}
+	})
+
+	b.Log(mCurrency.String())
+	b.Log(sCurrency.String())
+}
+
+func BenchmarkCalc(b *testing.B) {
+	aStr := "10000000000000000010000000000000.00000000000001"
+	bStr := "0.999999999999999999"
+	cStr := "12345678901.234567"
+
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		y, _ := NewFromString(bStr)
+
+		z, _ := NewFromString(cStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency, _ = Calc(x).Mul(y).Add(z).Round(2, HalfEven).Result()
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		x, _ := decimal.NewFromString(aStr)
+
+		y, _ := decimal.NewFromString(bStr)
+
+		z, _ := decimal.NewFromString(cStr)
+
+		for i := 0; i < b.N; i++ {
+			sCurrency = x.Mul(y).Add(z).RoundBank(2)
+		}
+	})
+
+	b.Log(mCurrency.String())
+	b.Log(sCurrency.String())
+}
+
+func BenchmarkDiv(b *testing.B) {
+	aStr := "8901234567890124190123456789012345612345678.9012345678"
+	bStr := "12345678901.234567"
+
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		y, _ := NewFromString(bStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.Div(y)
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		x, _ := decimal.NewFromString(aStr)
+
+		y, _ := decimal.NewFromString(bStr)
+
+		for i := 0; i < b.N; i++ {
+			sCurrency, _ = x.QuoRem(y, currencyDecimalDigits)
+		}
+	})
+
+	b.Log(mCurrency.String())
+	b.Log(sCurrency.String())
+}
+
+func BenchmarkDivInt64(b *testing.B) {
+	aStr := "8901234567890124190123456789012345612345678.9012345678"
+	bInt := int64(123456789)
+
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.DivInt64(bInt)
+		}
+	})
+
+	b.Run("moedinha-Div", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		y, _ := NewFromString(strconv.FormatInt(bInt, 10))
+
+		for i := 0; i < b.N; i++ {
+			mCurrency = x.Div(y)
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		x, _ := decimal.NewFromString(aStr)
+
+		y := decimal.NewFromInt(bInt)
+
+		for i := 0; i < b.N; i++ {
+			sCurrency, _ = x.QuoRem(y, currencyDecimalDigits)
+		}
+	})
+
+	b.Log(mCurrency.String())
+	b.Log(sCurrency.String())
+}
+
+func BenchmarkSqrt(b *testing.B) {
+	aStr := "8901234567890124190123456789012345612345678.9012345678"
+
+	var mCurrency Currency
+
+	b.Run("moedinha", func(b *testing.B) {
+		x, _ := NewFromString(aStr)
+
+		for i := 0; i < b.N; i++ {
+			mCurrency, _ = x.Sqrt()
+		}
+	})
+
+	b.Log(mCurrency.String())
+}
+
+// sliceBenchmarkLen is the amount of values of the slice benchmarks, like the amounts of a large report.
+const sliceBenchmarkLen = 1 << 20
+
+// BenchmarkOperandSizes compares the operations with operands of a single uint of integer digits, of some
+// uints, and of the greatest sizes whose product still fits.
+func BenchmarkOperandSizes(b *testing.B) {
+	greatest := func(digit string) string {
+		return strings.Repeat(digit, currencyMaxIntegerDigits/2) + "." + strings.Repeat(digit, currencyDecimalDigits)
+	}
+
+	sizes := []struct {
+		name       string
+		aStr, bStr string
+	}{
+		{"small", "1234.56", "78.9"},
+		{"medium", "123456789012345678901234.56", "98765432109876.54321"},
+		{"max", greatest("9"), greatest("8")},
+	}
+
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+		mLess     bool
+		sLess     bool
+	)
+
+	operations := []struct {
+		name      string
+		moedinha  func(x, y Currency)
+		reference func(x, y decimal.Decimal)
+	}{
+		{
+			"Add",
+			func(x, y Currency) { mCurrency = x.Add(y) },
+			func(x, y decimal.Decimal) { sCurrency = x.Add(y) },
+		},
+		{
+			"Sub",
+			func(x, y Currency) { mCurrency = x.Sub(y) },
+			func(x, y decimal.Decimal) { sCurrency = x.Sub(y) },
+		},
+		{
+			"Mul",
+			func(x, y Currency) { mCurrency = x.Mul(y) },
+			func(x, y decimal.Decimal) { sCurrency = x.Mul(y) },
+		},
+		{
+			"LessThan",
+			func(x, y Currency) { mLess = x.LessThan(y) },
+			func(x, y decimal.Decimal) { sLess = x.LessThan(y) },
+		},
+	}
+
+	for _, op := range operations {
+		for _, size := range sizes {
+			b.Run(op.name+"/"+size.name+"/moedinha", func(b *testing.B) {
+				x, _ := NewFromString(size.aStr)
+
+				y, _ := NewFromString(size.bStr)
+
+				for i := 0; i < b.N; i++ {
+					op.moedinha(x, y)
+				}
+			})
+
+			b.Run(op.name+"/"+size.name+"/shopspring", func(b *testing.B) {
+				x, _ := decimal.NewFromString(size.aStr)
+
+				y, _ := decimal.NewFromString(size.bStr)
+
+				for i := 0; i < b.N; i++ {
+					op.reference(x, y)
+				}
+			})
+		}
+	}
+
+	b.Log(mCurrency.String(), mLess)
+	b.Log(sCurrency.String(), sLess)
+}
+
+func BenchmarkSliceMake(b *testing.B) {
+	var (
+		mCurrencies []Currency
+		sCurrencies []decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		b.ReportMetric(float64(unsafe.Sizeof(Currency{})), "B/value")
+
+		for i := 0; i < b.N; i++ {
+			mCurrencies = make([]Currency, sliceBenchmarkLen)
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		b.ReportMetric(float64(unsafe.Sizeof(decimal.Decimal{})), "B/value")
+
+		for i := 0; i < b.N; i++ {
+			sCurrencies = make([]decimal.Decimal, sliceBenchmarkLen)
+		}
+	})
+
+	b.Log(len(mCurrencies), len(sCurrencies))
+}
+
+func BenchmarkSliceSum(b *testing.B) {
+	var (
+		mCurrency Currency
+		sCurrency decimal.Decimal
+	)
+
+	b.Run("moedinha", func(b *testing.B) {
+		values := make([]Currency, sliceBenchmarkLen)
+		for i := range values {
+			values[i] = one.MulInt64(int64(i)).DivInt64(100)
+		}
+
+		b.SetBytes(int64(unsafe.Sizeof(Currency{})) * sliceBenchmarkLen)
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			var sum Currency
+
+			for _, v := range values {
+				sum = sum.Add(v)
+			}
+
+			mCurrency = sum
+		}
+	})
+
+	b.Run("shopspring", func(b *testing.B) {
+		values := make([]decimal.Decimal, sliceBenchmarkLen)
+		for i := range values {
+			values[i] = decimal.New(int64(i), -2)
+		}
+
+		b.SetBytes(int64(unsafe.Sizeof(decimal.Decimal{})) * sliceBenchmarkLen)
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			var sum decimal.Decimal
+
+			for _, v := range values {
+				sum = sum.Add(v)
+			}
+
+			sCurrency = sum
 		}
 	})
 
@@ -0,0 +1,407 @@
+package moedinha
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// decTestDir is the directory of the General Decimal Arithmetic test cases, see http://speleotrove.com/decimal.
+const decTestDir = "testdata/dectest"
+
+// decTestRoundingModes maps the decTest rounding directive values to the supported rounding modes.
+var decTestRoundingModes = map[string]RoundingMode{
+	"down":      Down,
+	"up":        Up,
+	"ceiling":   Ceiling,
+	"floor":     Floor,
+	"half_up":   HalfUp,
+	"half_down": HalfDown,
+	"half_even": HalfEven,
+}
+
+// decTestCase is a single decTest case, like "addx001 add 1 1 -> 2".
+type decTestCase struct {
+	id         string
+	operation  string
+	operands   []string
+	result     string
+	conditions []string
+	rounding   string
+}
+
+// decTestResult is the outcome of a decTest case.
+type decTestResult int
+
+const (
+	decTestPassed decTestResult = iota
+	decTestSkipped
+	decTestFailed
+)
+
+// TestDecTest runs the General Decimal Arithmetic test cases of the supported operations. The decTest cases are
+// defined in terms of significant digits, so the cases that can't be represented with the fixed decimal digits,
+// like the ones with special values, exponents out of range or conditions without a Currency equivalent, are
+// skipped. The rounding to the precision is checked by rounding the Context result again to the expected exponent,
+// skipping the inexact results that landed exactly halfway, which can't be rounded again without double rounding.
+func TestDecTest(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(decTestDir, "*.decTest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) == 0 {
+		t.Fatalf("no decTest files found in %s", decTestDir)
+	}
+
+	for _, file := range files {
+		t.Run(strings.TrimSuffix(filepath.Base(file), ".decTest"), func(t *testing.T) {
+			cases, err := readDecTestFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var passed, skipped, failed int
+
+			for _, tc := range cases {
+				switch runDecTestCase(t, tc) {
+				case decTestPassed:
+					passed++
+				case decTestSkipped:
+					skipped++
+				case decTestFailed:
+					failed++
+				}
+			}
+
+			t.Logf("%d passed, %d failed, %d skipped", passed, failed, skipped)
+		})
+	}
+}
+
+// readDecTestFile reads the test cases of a decTest file, keeping the rounding directive of each case.
+func readDecTestFile(file string) ([]decTestCase, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		cases    []decTestCase
+		rounding string
+	)
+
+	scanner := bufio.NewScanner(f)
+
+	for line := 1; scanner.Scan(); line++ {
+		tokens := decTestTokens(scanner.Text())
+
+		switch {
+		case len(tokens) == 0:
+			continue
+		case strings.HasSuffix(tokens[0], ":"):
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("%s:%d: invalid directive", file, line)
+			}
+
+			if strings.EqualFold(tokens[0], "rounding:") {
+				rounding = strings.ToLower(tokens[1])
+			}
+
+			continue
+		}
+
+		arrow := -1
+
+		for i, token := range tokens {
+			if token == "->" {
+				arrow = i
+
+				break
+			}
+		}
+
+		if arrow < 2 || arrow == len(tokens)-1 {
+			return nil, fmt.Errorf("%s:%d: invalid test case", file, line)
+		}
+
+		cases = append(cases, decTestCase{
+			id:         tokens[0],
+			operation:  strings.ToLower(tokens[1]),
+			operands:   tokens[2:arrow],
+			result:     tokens[arrow+1],
+			conditions: tokens[arrow+2:],
+			rounding:   rounding,
+		})
+	}
+
+	return cases, scanner.Err()
+}
+
+// decTestTokens splits a decTest line into its tokens, removing the comments and the operand quotes.
+func decTestTokens(line string) []string {
+	var (
+		tokens []string
+		token  strings.Builder
+		quote  byte
+		quoted bool
+	)
+
+	flush := func() {
+		if token.Len() > 0 || quoted {
+			tokens = append(tokens, token.String())
+		}
+
+		token.Reset()
+
+		quoted = false
+	}
+
+	for i := 0; i < len(line); i++ {
+		ch := line[i]
+
+		switch {
+		case quote != 0 && ch == quote:
+			// A doubled quote is an escaped quote.
+			if i+1 < len(line) && line[i+1] == quote {
+				token.WriteByte(ch)
+				i++
+
+				continue
+			}
+
+			quote = 0
+		case quote != 0:
+			token.WriteByte(ch)
+		case ch == '\'' || ch == '"':
+			quote, quoted = ch, true
+		case ch == '-' && i+1 < len(line) && line[i+1] == '-' && token.Len() == 0:
+			flush()
+
+			return tokens
+		case ch == ' ' || ch == '\t':
+			flush()
+		default:
+			token.WriteByte(ch)
+		}
+	}
+
+	flush()
+
+	return tokens
+}
+
+// runDecTestCase runs a single decTest case, reporting the mismatches as test errors.
+func runDecTestCase(t *testing.T, tc decTestCase) decTestResult {
+	t.Helper()
+
+	mode, ok := decTestRoundingModes[tc.rounding]
+	if !ok {
+		return decTestSkipped
+	}
+
+	var conditions Condition
+
+	for _, condition := range tc.conditions {
+		switch condition {
+		case "Inexact":
+			conditions |= Inexact
+		case "Rounded":
+			conditions |= Rounded
+		case "Division_by_zero":
+			conditions |= DivisionByZero
+		case "Division_undefined":
+			conditions |= InvalidOperation
+		default:
+			// Conditions like Clamped, Subnormal and Overflow depend on the exponent limits.
+			return decTestSkipped
+		}
+	}
+
+	if len(tc.operands) != 2 {
+		return decTestSkipped
+	}
+
+	x, ok := parseDecTestNumber(tc.operands[0])
+	if !ok {
+		return decTestSkipped
+	}
+
+	y, ok := parseDecTestNumber(tc.operands[1])
+	if !ok {
+		return decTestSkipped
+	}
+
+	ctx := Context{Rounding: mode}
+
+	var (
+		result   string
+		exponent int
+	)
+
+	switch tc.operation {
+	case "compare":
+		if conditions != 0 {
+			return decTestSkipped
+		}
+
+		switch {
+		case x.LessThan(y):
+			result = "-1"
+		case x.GreaterThan(y):
+			result = "1"
+		default:
+			result = "0"
+		}
+
+		return checkDecTestCase(t, tc, result, conditions, conditions)
+	case "divide":
+		if conditions&(DivisionByZero|InvalidOperation) != 0 {
+			if _, err := ctx.Div(x, y); err != nil {
+				t.Fatal(err)
+			}
+
+			return checkDecTestCase(t, tc, tc.result, ctx.Flags&(DivisionByZero|InvalidOperation), conditions)
+		}
+	case "quantize":
+		d, ok := parseDecTestDecimal(tc.operands[1])
+		if !ok {
+			return decTestSkipped
+		}
+
+		exponent = int(d.Exponent())
+	}
+
+	expected, ok := parseDecTestDecimal(tc.result)
+	if !ok || !isDecTestRepresentable(expected) {
+		return decTestSkipped
+	}
+
+	if tc.operation != "quantize" {
+		exponent = int(expected.Exponent())
+	}
+
+	places := -exponent
+	if places > currencyDecimalDigits || places < -currencyMaxIntegerDigits {
+		return decTestSkipped
+	}
+
+	var (
+		value Currency
+		err   error
+	)
+
+	switch tc.operation {
+	case "add":
+		value, err = ctx.Add(x, y)
+	case "subtract":
+		value, err = ctx.Sub(x, y)
+	case "multiply":
+		value, err = ctx.Mul(x, y)
+	case "divide":
+		value, err = ctx.Div(x, y)
+	case "quantize":
+		value, err = ctx.Round(x, places)
+
+		// The Rounded condition of quantize is raised only when non-zero digits are discarded.
+		conditions &^= Rounded
+	default:
+		return decTestSkipped
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The result is rounded again to the expected exponent, keeping the conditions of the operation. Rounding
+	// an inexact result again gives the same result of rounding the exact one, unless it was rounded exactly
+	// halfway between the expected digits, where the exact result side is unknown.
+	if tc.operation != "quantize" {
+		if ctx.Flags&Overflow != 0 || ctx.Flags&Inexact != 0 && isDecTestHalfway(value, places) {
+			return decTestSkipped
+		}
+
+		if value, err = ctx.Round(value, places); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := decimal.NewFromString(value.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Equal(expected) {
+		result = tc.result
+	} else {
+		result = got.String()
+	}
+
+	return checkDecTestCase(t, tc, result, ctx.Flags&Inexact, conditions&Inexact)
+}
+
+// checkDecTestCase compares the case result and conditions with the expected ones.
+func checkDecTestCase(t *testing.T, tc decTestCase, result string, got, expected Condition) decTestResult {
+	t.Helper()
+
+	if result != tc.result || got != expected {
+		t.Errorf("%s %s %s: expected %s [%s], got %s [%s]",
+			tc.id, tc.operation, strings.Join(tc.operands, " "), tc.result, expected, result, got)
+
+		return decTestFailed
+	}
+
+	return decTestPassed
+}
+
+// isDecTestHalfway reports whether the discarded digits of c rounded to the given places are exactly half.
+func isDecTestHalfway(c Currency, places int) bool {
+	d := decimal.RequireFromString(c.String()).Shift(int32(places + 1))
+
+	return d.IsInteger() && d.Mod(decimal.NewFromInt(10)).Abs().Equal(decimal.NewFromInt(5))
+}
+
+// parseDecTestNumber parses a decTest operand as a Currency.
+// The second return reports whether the operand can be represented without rounding.
+func parseDecTestNumber(str string) (Currency, bool) {
+	d, ok := parseDecTestDecimal(str)
+	if !ok || !isDecTestRepresentable(d) {
+		return Currency{}, false
+	}
+
+	c, err := NewFromString(d.String())
+	if err != nil {
+		return Currency{}, false
+	}
+
+	return c, true
+}
+
+// parseDecTestDecimal parses a decTest number, skipping the special values and the huge exponents, that are
+// expensive to parse and can't be represented anyway. The second return reports whether the number was parsed.
+func parseDecTestDecimal(str string) (decimal.Decimal, bool) {
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		exponent, err := strconv.Atoi(str[i+1:])
+		if err != nil || exponent > naturalMaxLen || exponent < -naturalMaxLen {
+			return decimal.Decimal{}, false
+		}
+	}
+
+	d, err := decimal.NewFromString(str)
+
+	return d, err == nil
+}
+
+// isDecTestRepresentable reports whether d can be represented as a Currency without rounding.
+func isDecTestRepresentable(d decimal.Decimal) bool {
+	limit := decimal.New(1, currencyMaxIntegerDigits)
+
+	return d.Abs().LessThan(limit) && d.Equal(d.Truncate(currencyDecimalDigits))
+}
@@ -0,0 +1,184 @@
+package moedinha
+
+import "fmt"
+
+// Precision defines the digits of a Fixed number. Precisions are usually empty struct types, for example:
+//
+//	type Cents struct{}
+//
+//	func (Cents) IntegerDigits() int { return 16 }
+//	func (Cents) DecimalDigits() int { return 2 }
+//
+//	type Price = moedinha.Fixed[Cents]
+//
+// The integer digits should be between 0 and 54, and the decimal digits between 0 and 18, for the default
+// settings.go configuration, and the constructors of the Fixed numbers, like NewFixedFromString and MaxFixed,
+// panic for unsupported digits.
+//
+// A precision only restricts the digits: Fixed numbers of all precisions are stored with the same uints, defined
+// by settings.go, so the precision restricts the representable numbers without changing the memory size of the
+// values. Since Go type parameters can't define array lengths, choosing the amount of uints per precision would
+// require slices, or a copy of the arithmetic for each size, so a smaller storage still requires a generated copy
+// of the package (see moedinhagen).
+type Precision interface {
+	// IntegerDigits returns the maximum amount of digits before the decimal point.
+	IntegerDigits() int
+	// DecimalDigits returns the amount of digits after the decimal point.
+	DecimalDigits() int
+}
+
+// DefaultPrecision is the precision of Currency, with all the digits supported by the settings.go configuration.
+type DefaultPrecision struct{}
+
+// IntegerDigits returns the integer digits supported by the settings.go configuration, i.e. 54 for the default one.
+func (DefaultPrecision) IntegerDigits() int {
+	return currencyMaxIntegerDigits
+}
+
+// DecimalDigits returns the decimal digits supported by the settings.go configuration, i.e. 18 for the default one.
+func (DefaultPrecision) DecimalDigits() int {
+	return currencyDecimalDigits
+}
+
+// Fixed is a fixed-precision decimal number with the integer and decimal digits defined by P. The zero value is
+// the number zero, and every constructor and operation returns the same representation for equal numbers,
+// e.g. there's no negative zero. So, numbers can be compared with == and used as map keys.
+//
+// The operations round their results to the decimal digits of P, and the results that don't fit in the
+// integer digits of P are handled as overflows, so the operation docs referring to the supported digits
+// refer to the P digits. Numbers with different precisions can't be mixed in a single operation, and should
+// be converted explicitly (see Convert). Calc and Context only work on Currency, so the other precisions use
+// the checked and saturating variants of the operations instead.
+type Fixed[P Precision] struct {
+	t integer
+}
+
+// Currency is a Fixed number with all the digits supported by the settings.go configuration.
+type Currency = Fixed[DefaultPrecision]
+
+// NewFixedFromString returns the Fixed number represented by str. An error wrapping ErrInvalidFormat is
+// returned if str isn't a valid number, or if it has more integer or decimal digits than P, e.g. "1.255"
+// is invalid for a precision with 2 decimal digits, while "1.250" is valid.
+func NewFixedFromString[P Precision](str string) (Fixed[P], error) {
+	checkPrecision[P]()
+
+	c, err := NewFromString(str)
+	if err != nil {
+		return Fixed[P]{}, err
+	}
+
+	decimals, _ := precisionOf[P]()
+
+	if c.t.abs().hasDigitsBelow(currencyDecimalDigits-decimals) || !fits[P](c.t.abs()) {
+		return Fixed[P]{}, fmt.Errorf(`validating currency: "%s": unsupported digits: %w`, str, ErrInvalidFormat)
+	}
+
+	return Fixed[P]{t: c.t}, nil
+}
+
+// Convert returns c with the precision Q. An error wrapping ErrOverflow is returned if c doesn't fit in the
+// integer digits of Q, and an error wrapping ErrInexact if non-zero digits would be discarded to fit in the
+// decimal digits of Q (see ConvertRound).
+func Convert[Q, P Precision](c Fixed[P]) (Fixed[Q], error) {
+	checkPrecision[Q]()
+
+	result, exact, overflow := newFixed[Q](c.t.abs(), c.t.isNeg(), false, Down)
+	if overflow {
+		return Fixed[Q]{}, fmt.Errorf("converting %s: %w", c.String(), ErrOverflow)
+	}
+
+	if !exact {
+		return Fixed[Q]{}, fmt.Errorf("converting %s: %w", c.String(), ErrInexact)
+	}
+
+	return result, nil
+}
+
+// ConvertRound returns c with the precision Q like Convert, but rounding the digits that don't fit in the
+// decimal digits of Q using the given rounding mode. An error wrapping ErrOverflow is returned if the result
+// doesn't fit in the integer digits of Q.
+func ConvertRound[Q, P Precision](c Fixed[P], mode RoundingMode) (Fixed[Q], error) {
+	checkPrecision[Q]()
+
+	result, _, overflow := newFixed[Q](c.t.abs(), c.t.isNeg(), false, mode)
+	if overflow {
+		return Fixed[Q]{}, fmt.Errorf("converting %s: %w", c.String(), ErrOverflow)
+	}
+
+	return result, nil
+}
+
+// precisionOf returns the decimal and the integer digits of P, which are validated by checkPrecision when the
+// Fixed numbers are constructed, so the operations don't validate them again.
+func precisionOf[P Precision]() (int, int) {
+	var p P
+
+	return p.DecimalDigits(), p.IntegerDigits()
+}
+
+// checkPrecision panics if P has more digits than the supported by the settings.go configuration.
+func checkPrecision[P Precision]() {
+	decimals, integers := precisionOf[P]()
+
+	if decimals < 0 || decimals > currencyDecimalDigits || integers < 0 || integers > currencyMaxIntegerDigits {
+		panic(fmt.Sprintf("unsupported precision: %d integer digits and %d decimal digits", integers, decimals))
+	}
+}
+
+// fits reports whether n, a natural number with currencyDecimalDigits decimal digits, fits in the integer
+// digits of P.
+func fits[P Precision](n natural) bool {
+	_, integers := precisionOf[P]()
+
+	return fitsIntegerDigits(n, integers)
+}
+
+// fitsAbs reports whether the absolute value of t fits in the integer digits of P (see fits). Unlike fits, t is
+// passed by reference, so its absolute value is only copied by the precisions with less integer digits.
+func fitsAbs[P Precision](t *integer) bool {
+	_, integers := precisionOf[P]()
+
+	return integers == currencyMaxIntegerDigits || fitsIntegerDigits(t.abs(), integers)
+}
+
+// fitsIntegerDigits reports whether n, a natural number with currencyDecimalDigits decimal digits, fits in
+// the given integer digits.
+func fitsIntegerDigits(n natural, integers int) bool {
+	return integers == currencyMaxIntegerDigits || n.lessThan(pow10Natural(currencyDecimalDigits+integers))
+}
+
+// roundingFor returns the rounding mode of the intermediate results of the operations with precision P.
+// If P has less decimal digits than the supported ones, the intermediate results are truncated, and then
+// rounded a single time to the P decimal digits by newFixed, which avoids rounding the same number twice.
+func roundingFor[P Precision](mode RoundingMode) RoundingMode {
+	if decimals, _ := precisionOf[P](); decimals < currencyDecimalDigits {
+		return Down
+	}
+
+	return mode
+}
+
+// newFixed returns the Fixed number represented by n, a natural number with currencyDecimalDigits decimal
+// digits, rounded to the decimal digits of P using the given rounding mode. The neg argument tells whether
+// the number is negative, and sticky whether non-zero digits after the least significant digit of n were
+// already discarded. The second return reports whether the result is exact, i.e. no non-zero digit was
+// discarded, and the last return reports whether the result doesn't fit in the integer digits of P.
+func newFixed[P Precision](n natural, neg, sticky bool, mode RoundingMode) (Fixed[P], bool, bool) {
+	decimals, integers := precisionOf[P]()
+
+	// The precisions with all the supported digits, like the Currency one, neither round nor restrict n.
+	if decimals == currencyDecimalDigits && integers == currencyMaxIntegerDigits {
+		return Fixed[P]{t: newInteger(n, neg)}, !sticky, false
+	}
+
+	digits := currencyDecimalDigits - decimals
+
+	exact := !sticky && !n.hasDigitsBelow(digits)
+
+	n, overflow := n.round(digits, mode, neg, sticky)
+	if overflow || !fitsIntegerDigits(n, integers) {
+		return Fixed[P]{}, exact, true
+	}
+
+	return Fixed[P]{t: newInteger(n, neg)}, exact, false
+}
@@ -0,0 +1,154 @@
+package moedinha
+
+// Format configures the text written by AppendFormat. The zero value writes the integer part of the number,
+// rounded towards zero, without padding.
+type Format struct {
+	// Decimals is the amount of decimal places written, padded with trailing zeros if needed. A negative value
+	// writes only the significant decimal places, like String.
+	Decimals int
+	// Rounding is the rounding mode used to discard the decimal places beyond Decimals.
+	Rounding RoundingMode
+	// Width is the minimum length of the text, which is padded at the left with Pad.
+	Width int
+	// Pad is the byte used to pad the text to Width, or a space if zero. The '0' padding is written after
+	// the negative sign, e.g. "-001.50".
+	Pad byte
+}
+
+// AppendText appends the String representation of c to b, returning the extended buffer.
+// It implements the encoding.TextAppender interface, and never returns an error.
+func (c Fixed[P]) AppendText(b []byte) ([]byte, error) {
+	return c.AppendFormat(b, Format{Decimals: -1}), nil
+}
+
+// AppendFormat appends the text representation of c configured by f to b, returning the extended buffer.
+// Since the text isn't limited to the supported digits, rounding never overflows, e.g. formatting the
+// greatest number with no decimal places rounding up results in a number with an additional integer digit.
+// The negative sign is omitted when all the written digits are zeros.
+func (c Fixed[P]) AppendFormat(b []byte, f Format) []byte {
+	neg := c.t.isNeg()
+
+	digits := c.t.abs().string()
+
+	integers, decimals := digits[:naturalMaxLen-currencyDecimalDigits], digits[naturalMaxLen-currencyDecimalDigits:]
+
+	places := f.Decimals
+	if places < 0 {
+		places = len(decimals)
+		for places > 0 && decimals[places-1] == zeroRune {
+			places--
+		}
+	}
+
+	// Rounding the kept digits in place, where a carry out of the integer digits becomes a leading 1.
+	kept := min(places, len(decimals))
+
+	var carry bool
+
+	if kept < len(decimals) && roundsUpDigits(digits[:len(integers)+kept], decimals[kept:], f.Rounding, neg) {
+		carry = incrementDigits(digits[:len(integers)+kept])
+	}
+
+	// Removing the leading zeros, but keeping at least one integer digit. After a carry, all the integer
+	// digits are zeros following the leading 1.
+	start := 0
+	for !carry && start < len(integers)-1 && integers[start] == zeroRune {
+		start++
+	}
+
+	if !carry && isZeroDigits(digits[start:len(integers)+kept]) {
+		neg = false
+	}
+
+	length := len(integers) - start
+	if carry {
+		length++
+	}
+
+	if neg {
+		length++
+	}
+
+	if places > 0 {
+		length += 1 + places
+	}
+
+	pad := f.Pad
+	if pad == 0 {
+		pad = ' '
+	}
+
+	padding := f.Width - length
+
+	if pad != zeroRune {
+		b = appendRepeated(b, pad, padding)
+	}
+
+	if neg {
+		b = append(b, integerNegativeSymbol)
+	}
+
+	if pad == zeroRune {
+		b = appendRepeated(b, pad, padding)
+	}
+
+	if carry {
+		b = append(b, '1')
+	}
+
+	b = append(b, integers[start:]...)
+
+	if places > 0 {
+		b = append(b, currencyDecimalSeparatorSymbol)
+		b = append(b, decimals[:kept]...)
+		b = appendRepeated(b, zeroRune, places-kept)
+	}
+
+	return b
+}
+
+// roundsUpDigits reports whether the kept digits should be incremented by one unit when discarding the
+// given digits, following the rounding mode. The neg argument tells whether the number is negative.
+func roundsUpDigits(kept, discarded []byte, mode RoundingMode, neg bool) bool {
+	first := uint64(discarded[0] - zeroRune)
+	sticky := !isZeroDigits(discarded[1:])
+
+	odd := len(kept) > 0 && (kept[len(kept)-1]-zeroRune)%2 == 1
+
+	return mode.roundsUp(neg, odd, compareHalfFromDigit(first, sticky), first != 0 || sticky)
+}
+
+// incrementDigits adds one unit to the decimal digits, reporting whether it carries out of them.
+func incrementDigits(digits []byte) bool {
+	for i := len(digits) - 1; i >= 0; i-- {
+		if digits[i] != '9' {
+			digits[i]++
+
+			return false
+		}
+
+		digits[i] = zeroRune
+	}
+
+	return true
+}
+
+// isZeroDigits reports whether all the decimal digits are zeros.
+func isZeroDigits(digits []byte) bool {
+	for _, d := range digits {
+		if d != zeroRune {
+			return false
+		}
+	}
+
+	return true
+}
+
+// appendRepeated appends n copies of ch to b, or nothing for a non-positive n.
+func appendRepeated(b []byte, ch byte, n int) []byte {
+	for ; n > 0; n-- {
+		b = append(b, ch)
+	}
+
+	return b
+}
@@ -1,41 +1,56 @@
 package moedinha
 
-import (
-	"fmt"
-)
-
 const (
 	// integerNegativeSymbol symbol used to represent a negative number as a string.
 	integerNegativeSymbol = '-'
 	// integerMaxLen is the maximum length that an integer string.
 	// +1 to the possible negative symbol.
 	integerMaxLen = naturalMaxLen + 1
+	// smallUints is the amount of least significant uints of the small integers (see isSmall), i.e. an integer
+	// and a decimal uint for the default settings.
+	smallUints = 2
 )
 
-type integer struct {
-	n   natural
-	neg bool
-}
+// integerSignBit is the bit of the first uint storing the integer sign. Since each uint is lesser
+// than 10^18 < 2^60, the most significant bits are never used by the natural digits.
+const integerSignBit = 1 << 63
 
-func newIntegerFromString(str [integerMaxLen]byte) (integer, error) {
-	var neg bool
-	if str[0] == integerNegativeSymbol {
-		neg = true
+// integer is a signed natural number, with the sign packed into the most significant bit of the first
+// uint, so it has the same size of a natural number. Zero is always represented as non-negative, so two
+// equal integers always have the same representation, and can be compared with == or used as map keys.
+type integer natural
+
+// newInteger returns the integer with the given absolute value and sign, discarding the sign of zero.
+func newInteger(n natural, neg bool) integer {
+	if neg && !n.isZero() {
+		n[0] |= integerSignBit
 	}
 
-	var natStr [naturalMaxLen]byte
+	return integer(n)
+}
 
-	copy(natStr[:], str[1:])
+// abs returns the absolute value of t.
+func (t integer) abs() natural {
+	t[0] &^= integerSignBit
 
-	n, err := newNatFromString(natStr)
-	if err != nil {
-		return integer{}, fmt.Errorf("creating underlyin natural number from string: %w", err)
-	}
+	return natural(t)
+}
+
+// mulAbs stores the double precision product of the absolute values of t and v in dst (see mulNaturals).
+func (t integer) mulAbs(v integer, dst *wide) {
+	// The sign bits are cleared in place, since reading the copies returned by abs right after their sign bits
+	// were cleared is slower than the multiplication itself.
+	n, m := natural(t), natural(v)
+
+	n[0] &^= integerSignBit
+	m[0] &^= integerSignBit
+
+	mulNaturals(dst, &n, &m)
+}
 
-	return integer{
-		n:   n,
-		neg: neg,
-	}, nil
+// isNeg reports whether t is negative.
+func (t integer) isNeg() bool {
+	return t[0]&integerSignBit != 0
 }
 
 func (t integer) string() [integerMaxLen]byte {
@@ -47,11 +62,11 @@ func (t integer) string() [integerMaxLen]byte {
 		return intString
 	}
 
-	if t.neg {
+	if t.isNeg() {
 		intString[0] = integerNegativeSymbol
 	}
 
-	natString := t.n.string()
+	natString := t.abs().string()
 
 	copy(intString[1:], natString[:])
 
@@ -59,210 +74,182 @@ func (t integer) string() [integerMaxLen]byte {
 }
 
 // add sum two integers.
-func (t integer) add(v integer) integer {
-	// "(+t)+(+v) = t+v" or "(-t)+(-v) = -(t+v)"
-	if t.neg == v.neg {
-		return integer{
-			n:   t.n.add(v.n),
-			neg: t.neg,
+// The second return reports whether the operation overflows.
+func (t integer) add(v integer) (result integer, overflow bool) {
+	neg := t.isNeg()
+
+	switch {
+	case t.isSmall() && v.isSmall():
+		if addSmallAbs(&result, &t, &v, neg == v.isNeg()) {
+			neg = !neg
 		}
+	case neg == v.isNeg():
+		// "(+t)+(+v) = t+v" or "(-t)+(-v) = -(t+v)"
+		overflow = t.addAbs(v, &result)
+	case t.subAbs(v, &result):
+		// For now on, signs are different: "t+(-v) = t-v" or "(-t)+v = -(t-v)", where "t-v = -(v-t)".
+		neg = !neg
 	}
 
-	// For now on, signs are different.
+	result.setSign(neg)
 
-	// C is negative.
-	// v - t
-	if t.neg {
-		return v.sub(integer{
-			n:   t.n,
-			neg: false,
-		})
+	return result, overflow
+}
+
+// sub calculates the subtraction "t - v".
+// The second return reports whether the operation overflows.
+func (t integer) sub(v integer) (result integer, overflow bool) {
+	neg := t.isNeg()
+
+	switch {
+	case t.isSmall() && v.isSmall():
+		if addSmallAbs(&result, &t, &v, neg != v.isNeg()) {
+			neg = !neg
+		}
+	case neg != v.isNeg():
+		// "t-(-v) = t+v" or "(-t)-v = -(t+v)"
+		overflow = t.addAbs(v, &result)
+	case t.subAbs(v, &result):
+		// For now on, signs are equal: "t-v" or "(-t)-(-v) = -(t-v)", where "t-v = -(v-t)".
+		neg = !neg
 	}
 
-	// V is negative.
-	// t - v
+	result.setSign(neg)
 
-	return t.sub(integer{
-		n:   v.n,
-		neg: false,
-	})
+	return result, overflow
 }
 
-// sub calculates the subtraction "t - v".
-func (t integer) sub(v integer) integer {
-	if t.equal(v) {
-		return integer{}
+// isSmall reports whether only the smallUints least significant uints of t are non-zero. Since the sums of
+// small integers fit in smallUints+1 uints, they never overflow, and are calculated by addSmallAbs without
+// touching the other uints.
+func (t *integer) isSmall() bool {
+	if numberOfUints <= smallUints {
+		return false
 	}
 
-	// different signs
-	if t.neg != v.neg {
-		// t - (-v) = t + v
-		if v.neg {
-			return integer{
-				n:   t.n.add(v.n),
-				neg: false,
-			}
-		}
+	if t[0]&^integerSignBit != 0 {
+		return false
+	}
 
-		// -c - v = - (c+v)
-		return integer{
-			n:   t.n.add(v.n),
-			neg: true,
+	for i := 1; i < numberOfUints-smallUints; i++ {
+		if t[i] != 0 {
+			return false
 		}
 	}
 
-	// for now on, equal sign
-
-	// both negative numbers
-	// -t - (-v) = v - t
-	if t.neg {
-		// negative result.
-		if t.n.greaterThan(v.n) {
-			// v - t = -(t-v)
-			return integer{
-				n:   t.n.sub(v.n),
-				neg: true,
-			}
-		}
+	return true
+}
 
-		// positive result
-		return integer{
-			n:   v.n.sub(t.n),
-			neg: false,
-		}
-	}
+// addSmallAbs stores the sum of the absolute values of the small integers t and v in dst when add is true, or
+// the absolute value of their subtraction otherwise, where the last return reports whether the absolute value
+// of v is greater than the absolute value of t (see isSmall).
+func addSmallAbs(dst, t, v *integer, add bool) bool {
+	// The indexes of the small uints, and of the uint receiving the carry of their sum.
+	const hi, lo, carryIndex = max(numberOfUints-2, 0), numberOfUints - 1, max(numberOfUints-3, 0)
 
-	// both positive
-	// c - v
+	tHi, tLo, vHi, vLo := t[hi], t[lo], v[hi], v[lo]
 
-	// negative result
-	if v.n.greaterThan(t.n) {
-		// t - v = -(v - t)
-		return integer{
-			n:   v.n.sub(t.n),
-			neg: true,
-		}
-	}
+	if add {
+		var carry uint64
+
+		dst[lo], carry = addUint(tLo, vLo)
+		dst[hi], dst[carryIndex] = addUint(tHi, vHi+carry)
 
-	// positive result
-	return integer{
-		n:   t.n.sub(v.n),
-		neg: false,
+		return false
 	}
-}
 
-// mul multiplies two integer numbers.
-// The first return is the result, and the second return is the overflow
-// of the operation, if any, as a natural number.
-func (t integer) mul(v integer) (integer, natural) {
-	natResult, natOverflow := t.n.mul(v.n)
-
-	if t.neg == v.neg {
-		return integer{
-			n:   natResult,
-			neg: false,
-		}, natOverflow
+	swapped := tHi < vHi || tHi == vHi && tLo < vLo
+	if swapped {
+		tHi, tLo, vHi, vLo = vHi, vLo, tHi, tLo
 	}
 
-	return integer{
-		n:   natResult,
-		neg: true,
-	}, natOverflow
-}
+	var borrow uint64
 
-func (t integer) isZero() bool {
-	return t.n.isZero()
+	dst[lo], borrow = subUint(tLo, vLo)
+	dst[hi], _ = subUint(tHi, vHi+borrow)
+
+	return swapped
 }
 
-func (t integer) equal(v integer) bool {
-	// -0 should be equal to +0.
-	if t.n.isZero() && v.n.isZero() {
-		return true
-	}
+// addAbs stores the sum of the absolute values of t and v in dst, reporting whether the operation overflows.
+func (t integer) addAbs(v integer, dst *integer) bool {
+	// The sign bits are cleared in place, like in mulAbs.
+	n, m := natural(t), natural(v)
 
-	return t.neg == v.neg && t.n.equal(v.n)
+	n[0] &^= integerSignBit
+	m[0] &^= integerSignBit
+
+	return addNaturals((*natural)(dst), &n, &m) > 0
 }
 
-func (t integer) greaterThan(v integer) bool {
-	if t.isZero() && v.isZero() {
-		return false
-	}
+// subAbs stores the absolute value of the subtraction of the absolute values of t and v in dst, reporting
+// whether the absolute value of v is greater than the absolute value of t.
+func (t integer) subAbs(v integer, dst *integer) bool {
+	// The sign bits are cleared in place, like in mulAbs.
+	n, m := natural(t), natural(v)
 
-	// equal signal
-	if neg := t.neg; neg == v.neg {
-		if neg {
-			return t.n.lessThan(v.n)
-		}
+	n[0] &^= integerSignBit
+	m[0] &^= integerSignBit
 
-		return t.n.greaterThan(v.n)
-	}
+	if subNaturals((*natural)(dst), &n, &m) {
+		subNaturals((*natural)(dst), &m, &n)
 
-	if t.neg {
-		return false
+		return true
 	}
 
-	return true
+	return false
 }
 
-func (t integer) greaterThanOrEqual(v integer) bool {
-	if t.equal(v) {
-		return true
+// setSign sets the sign bit of the non-zero t when neg is true, like newInteger, but without copying t.
+func (t *integer) setSign(neg bool) {
+	if neg && !isZeroUints(t[:]) {
+		t[0] |= integerSignBit
 	}
+}
 
-	// equal signal
-	if neg := t.neg; neg == v.neg {
-		if neg {
-			return t.n.lessThan(v.n)
-		}
+func (t integer) isZero() bool {
+	return t == integer{}
+}
 
-		return t.n.greaterThan(v.n)
-	}
+func (t integer) equal(v integer) bool {
+	// Since zero is always non-negative, equal integers have the same representation.
+	return t == v
+}
 
-	if t.neg {
-		return false
-	}
+func (t integer) greaterThan(v integer) bool {
+	return t.cmp(v) > 0
+}
 
-	return true
+func (t integer) greaterThanOrEqual(v integer) bool {
+	return t.cmp(v) >= 0
 }
 
 func (t integer) lessThan(v integer) bool {
-	if t.isZero() && v.isZero() {
-		return false
-	}
-
-	// equal signs
-	if neg := t.neg; neg == v.neg {
-		if neg {
-			return t.n.greaterThan(v.n)
-		}
-
-		return t.n.lessThan(v.n)
-	}
-
-	if t.neg {
-		return true
-	}
-
-	return false
+	return t.cmp(v) < 0
 }
 
 func (t integer) lessThanOrEqual(v integer) bool {
-	if t.equal(v) {
-		return true
-	}
+	return t.cmp(v) <= 0
+}
+
+// cmp returns -1 if t is lesser than v, 0 if they are equal, and 1 if t is greater than v.
+func (t integer) cmp(v integer) int {
+	neg := t.isNeg()
 
-	// equal signs
-	if neg := t.neg; neg == v.neg {
+	// different signs
+	if neg != v.isNeg() {
 		if neg {
-			return t.n.greaterThan(v.n)
+			return -1
 		}
 
-		return t.n.lessThan(v.n)
+		return 1
 	}
 
-	if t.neg {
-		return true
+	// Since the signs are equal, the sign bits don't change the comparison of the uints, and the greater
+	// absolute value is the lesser negative number.
+	if neg {
+		return natural(v).cmp(natural(t))
 	}
 
-	return false
+	return natural(t).cmp(natural(v))
 }
@@ -2,6 +2,8 @@ package moedinha
 
 import (
 	"fmt"
+	"math"
+	"math/bits"
 )
 
 // naturalMaxLen is the max length of a natural number string .
@@ -10,24 +12,6 @@ const naturalMaxLen = numberOfUints * maxDigitsPerUint
 // natural represents a natural number.
 type natural [numberOfUints]uint64
 
-func newNatFromString(v [naturalMaxLen]byte) (natural, error) {
-	var n natural
-
-	for i := 0; i < numberOfUints; i++ {
-		var str [maxDigitsPerUint]byte
-		copy(str[:], v[i*maxDigitsPerUint:(i+1)*maxDigitsPerUint])
-
-		c, err := atoi(str)
-		if err != nil {
-			return natural{}, fmt.Errorf("error decoding natural number: %w", err)
-		}
-
-		n[i] = c
-	}
-
-	return n, nil
-}
-
 func (n natural) string() [naturalMaxLen]byte {
 	var str [naturalMaxLen]byte
 
@@ -41,24 +25,42 @@ func (n natural) string() [naturalMaxLen]byte {
 
 // add sums two natural numbers. This operation panics on overflow.
 func (n natural) add(v natural) natural {
+	result, over := n.addOverflow(v)
+
+	if over > 0 {
+		panic(fmt.Sprintf("natural number overflow: %s + %s", n.string(), v.string()))
+	}
+
+	return result
+}
+
+// addOverflow sums two natural numbers (see addNaturals).
+// The first return is the result, and the second return is the overflow
+// of the operation, if any.
+func (n natural) addOverflow(v natural) (natural, uint64) {
 	var result natural
 
-	for i := numberOfUints - 1; i >= 0; i-- {
-		result[i] = n[i] + v[i]
+	over := addNaturals(&result, &n, &v)
 
-		if i != numberOfUints-1 {
-			result[i+1], result[i] = rebalance(result[i+1], result[i])
-		}
-	}
+	return result, over
+}
 
-	var over uint64
-	result[0], over = rebalance(result[0], over)
+// addNaturals stores n + v in dst, and returns the overflow of the operation. Like in mulNaturals, the numbers
+// are passed by reference.
+func addNaturals(dst, n, v *natural) uint64 {
+	var carry uint64
 
-	if over > 0 {
-		panic(fmt.Sprintf("natural number overflow: %s + %s", n.string(), v.string()))
+	for i := numberOfUints - 1; i >= 0; i-- {
+		dst[i] = n[i] + v[i] + carry
+		carry = 0
+
+		if dst[i] > maxValuePerUint {
+			dst[i] -= maxValuePerUint + 1
+			carry = 1
+		}
 	}
 
-	return result
+	return carry
 }
 
 // padRight moves the components of the natural number to right.
@@ -120,20 +122,30 @@ func (n natural) padLeft(padding int) (natural, natural) {
 	return padded, overflow
 }
 
-// sub calculates the subtraction: n - v.
-// "v" should be lesser than "n" to not overflow the natural domain.
+// sub calculates the subtraction: n - v (see subNaturals).
+// "v" should be lesser or equal than "n" to not underflow the natural domain, otherwise this operation panics.
 func (n natural) sub(v natural) natural {
-	vCompl := n.complement()
-	sum := v.add(vCompl)
-
 	var result natural
-	for i := 0; i < numberOfUints; i++ {
-		result[i] = maxValuePerUint - sum[i]
+
+	if subNaturals(&result, &n, &v) {
+		panic(fmt.Sprintf("natural number underflow: %s - %s", n.string(), v.string()))
 	}
 
 	return result
 }
 
+// subNaturals stores n - v in dst, and reports whether the subtraction underflows, i.e. v is greater than n.
+// Like in mulNaturals, the numbers are passed by reference.
+func subNaturals(dst, n, v *natural) bool {
+	var borrow uint64
+
+	for i := numberOfUints - 1; i >= 0; i-- {
+		dst[i], borrow = subUint(n[i], v[i]+borrow)
+	}
+
+	return borrow != 0
+}
+
 // complement calculates the complement of n.
 // Complement is basically 999...(# of digits) - n.
 func (n natural) complement() natural {
@@ -146,41 +158,60 @@ func (n natural) complement() natural {
 	return result
 }
 
-// mul multiplies two natural numbers.
-// The first return is the result, and the second return is the overflow
-// of the operation, if any.
-func (n natural) mul(v natural) (natural, natural) {
-	var result, overflow natural
+// mulNaturals stores the double precision product of two natural numbers in dst, where the first numberOfUints
+// uints are the overflow of the operation. Unlike the natural methods, the numbers are passed by reference, since
+// copying them dominates the cost of the multiplications.
+func mulNaturals(dst *wide, n, v *natural) {
+	// The product is calculated by columns, where the column k accumulates the 128 bits products of the
+	// uints n[i] and v[j] with i+j+1 = k. Each product is lesser than 10^36, so a column with up to
+	// numberOfUints products is lesser than numberOfUints.10^36.
+	var hi, lo [wideUints]uint64
 
-	for i := 0; i < numberOfUints; i++ {
-		mr, mo := n.mulByUint64(v[i])
+	// Only the uints after the leading zeros are multiplied, and the rows of the zero uints are skipped.
+	nStart, vStart := n.leadingZeroUints(), v.leadingZeroUints()
+
+	for i := nStart; i < numberOfUints; i++ {
+		if n[i] == 0 {
+			continue
+		}
 
-		padded, paddingOverflow := mr.padLeft(numberOfUints - i - 1)
+		for j := vStart; j < numberOfUints; j++ {
+			pHi, pLo := bits.Mul64(n[i], v[j])
 
-		overflow[i] += mo
-		overflow = overflow.add(paddingOverflow)
-		result = result.add(padded)
+			var carry uint64
+			lo[i+j+1], carry = bits.Add64(lo[i+j+1], pLo, 0)
+			hi[i+j+1] += pHi + carry
+		}
 	}
 
-	return result, overflow
+	// The carry of the previous column is added to each column, which is then split by the maxValuePerUint
+	// boundary with a single division. Since a column with its carry is lesser than (numberOfUints+1).10^36,
+	// the carry is lesser than (numberOfUints+1).10^18, which fits in an uint64 for up to 17 uints.
+	var carry uint64
+
+	// Since the product has at most wideUints-nStart-vStart uints, the columns before nStart+vStart are zeros.
+	clear(dst[:nStart+vStart])
+
+	for k := wideUints - 1; k >= nStart+vStart; k-- {
+		var c uint64
+		lo[k], c = bits.Add64(lo[k], carry, 0)
+		hi[k] += c
+
+		if hi[k] == 0 {
+			carry, dst[k] = lo[k]/(maxValuePerUint+1), lo[k]%(maxValuePerUint+1)
+		} else {
+			carry, dst[k] = bits.Div64(hi[k], lo[k], maxValuePerUint+1)
+		}
+	}
 }
 
 // mulByUint64 multiplies a natural number by an uint64.
 // The first return is the result, and the second return is the overflow
 // of the operation, if any.
 func (n natural) mulByUint64(x uint64) (natural, uint64) {
-	var result, overflow natural
-
-	for i := numberOfUints - 1; i >= 0; i-- {
-		result[i], overflow[i] = multiplyUint(n[i], x)
-
-		if i != numberOfUints-1 {
-			result[i] += overflow[i+1]
-			result[i+1], result[i] = rebalance(result[i+1], result[i])
-		}
-	}
+	carry := mulUintsByUint(n[:], x)
 
-	return result, overflow[0]
+	return n, carry
 }
 
 func (n natural) isZero() bool {
@@ -192,47 +223,654 @@ func (n natural) equal(v natural) bool {
 }
 
 func (n natural) greaterThan(v natural) bool {
-	return n.greaterOnEqual(v, false)
+	return n.cmp(v) > 0
 }
 
 func (n natural) greaterThanOrEqual(v natural) bool {
-	return n.greaterOnEqual(v, true)
+	return n.cmp(v) >= 0
 }
 
-func (n natural) greaterOnEqual(v natural, onEqual bool) bool {
+func (n natural) lessThan(v natural) bool {
+	return n.cmp(v) < 0
+}
+
+func (n natural) lessThanOrEqual(v natural) bool {
+	return n.cmp(v) <= 0
+}
+
+// cmp returns -1 if n is lesser than v, 0 if they are equal, and 1 if n is greater than v.
+// The comparison stops at the first different uint, so the common leading zeros are the only
+// insignificant uints touched.
+func (n natural) cmp(v natural) int {
 	for i := 0; i < numberOfUints; i++ {
-		if n[i] > v[i] {
-			return true
+		switch {
+		case n[i] > v[i]:
+			return 1
+		case n[i] < v[i]:
+			return -1
 		}
+	}
 
-		if n[i] < v[i] {
-			return false
+	return 0
+}
+
+// wideUints is the amount of uints used by double-width natural numbers, like the ones
+// formed by the mul result and overflow.
+const wideUints = 2 * numberOfUints
+
+// quoRem calculates the quotient and the remainder of the division n / v.
+// This operation panics if v is zero.
+func (n natural) quoRem(v natural) (natural, natural) {
+	_, q, r := quoRemWide(natural{}, n, v)
+
+	return q, r
+}
+
+// quoRemWide divides the double-width natural number formed by "hi" and "lo" by v,
+// where "hi" is the most significant part, like the overflow returned by mul.
+// The first two returns are the high and low parts of the quotient, and the
+// last return is the remainder. This operation panics if v is zero.
+func quoRemWide(hi, lo, v natural) (natural, natural, natural) {
+	// Ignoring the divisor leading zeros.
+	s := 0
+	for s < numberOfUints && v[s] == 0 {
+		s++
+	}
+
+	if s == numberOfUints {
+		panic("natural number division by zero")
+	}
+
+	// The dividend has an extra leading uint to store the normalization overflow.
+	var u, q [wideUints + 1]uint64
+
+	copy(u[1:], hi[:])
+	copy(u[numberOfUints+1:], lo[:])
+
+	var r natural
+
+	// The divisor is a copy, so it can be modified by the division algorithms.
+	if s == numberOfUints-1 {
+		r[numberOfUints-1] = shortDivision(q[:], u[:], v[s])
+	} else {
+		longDivision(q[:], u[:], v[s:])
+
+		copy(r[s:], u[len(u)-(numberOfUints-s):])
+	}
+
+	var qHi, qLo natural
+
+	copy(qHi[:], q[1:numberOfUints+1])
+	copy(qLo[:], q[numberOfUints+1:])
+
+	return qHi, qLo, r
+}
+
+// shortDivision divides u by the single uint d, storing the quotient in q.
+// The return is the remainder.
+func shortDivision(q, u []uint64, d uint64) uint64 {
+	var r uint64
+
+	for i := range u {
+		q[i], r = divUint(r, u[i], d)
+	}
+
+	return r
+}
+
+// longDivision divides u by v using the Knuth's algorithm D, storing the quotient in q and leaving
+// the remainder at the last len(v) uints of u. The first uint of u should be zero, and the first
+// uint of v should be non-zero. The divisor v is modified by this operation.
+func longDivision(q, u, vn []uint64) {
+	n := len(vn)
+
+	// Normalizing the divisor, ensuring that its first uint is at least half of the base.
+	// This ensures that the quotient estimation is at most 2 units greater than the actual quotient.
+	d := (maxValuePerUint + 1) / (vn[0] + 1)
+
+	mulUintsByUint(vn, d)
+	mulUintsByUint(u, d)
+
+	for j := 0; j+n < len(u); j++ {
+		// Estimating the quotient from the first two uints of the current dividend.
+		var qHat, rHat uint64
+		if u[j] >= vn[0] {
+			// Since u[j] can't be greater than vn[0], the remainder of
+			// (u[j].(maxValuePerUint+1) + u[j+1]) / vn[0] is simplified.
+			qHat = maxValuePerUint
+			rHat = u[j+1] + vn[0]
+		} else {
+			qHat, rHat = divUint(u[j], u[j+1], vn[0])
+		}
+
+		// Correcting the estimation with the second divisor uint.
+		for n > 1 && rHat <= maxValuePerUint && mulGreaterThan(qHat, vn[1], rHat, u[j+2]) {
+			qHat--
+			rHat += vn[0]
+		}
+
+		// Subtracting qHat*vn from the current dividend.
+		var carry, borrow uint64
+		for i := n - 1; i >= 0; i-- {
+			var p uint64
+			p, carry = mulAddUint(qHat, vn[i], carry)
+
+			u[j+i+1], borrow = subUint(u[j+i+1], p+borrow)
+		}
+
+		top := int64(u[j]) - int64(carry) - int64(borrow)
+
+		// The estimation was 1 unit greater than the actual quotient, adding the divisor back.
+		if top < 0 {
+			qHat--
+
+			carry = 0
+			for i := n - 1; i >= 0; i-- {
+				u[j+i+1], carry = rebalance(u[j+i+1]+vn[i]+carry, 0)
+			}
+
+			top += int64(carry)
 		}
+
+		u[j] = uint64(top)
+		q[j+n] = qHat
 	}
 
-	// are equal
-	return onEqual
+	// Denormalizing the remainder.
+	shortDivision(u[len(u)-n:], u[len(u)-n:], d)
 }
 
-func (n natural) lessThan(v natural) bool {
-	return n.lessOnEqual(v, false)
+// mulUintsByUint multiplies in place the number represented by the uints x by d.
+// The return is the overflow of the operation.
+func mulUintsByUint(x []uint64, d uint64) uint64 {
+	var carry uint64
+
+	for i := len(x) - 1; i >= 0; i-- {
+		x[i], carry = mulAddUint(x[i], d, carry)
+	}
+
+	return carry
 }
 
-func (n natural) lessThanOrEqual(v natural) bool {
-	return n.lessOnEqual(v, false)
+// digitAt returns the decimal digit at the given position, where
+// position 0 is the least significant digit.
+func (n natural) digitAt(position int) uint64 {
+	if position < 0 || position >= naturalMaxLen {
+		return 0
+	}
+
+	return n[numberOfUints-1-position/maxDigitsPerUint] / pow10[position%maxDigitsPerUint] % base
 }
 
-func (n natural) lessOnEqual(v natural, onEqual bool) bool {
-	for i := 0; i < numberOfUints; i++ {
-		if n[i] < v[i] {
+// hasDigitsBelow reports whether there is any non-zero decimal digit
+// before the given position.
+func (n natural) hasDigitsBelow(position int) bool {
+	if position >= naturalMaxLen {
+		return !n.isZero()
+	}
+
+	for i := 0; i < position/maxDigitsPerUint; i++ {
+		if n[numberOfUints-1-i] != 0 {
 			return true
 		}
+	}
+
+	if position <= 0 {
+		return false
+	}
+
+	return n[numberOfUints-1-position/maxDigitsPerUint]%pow10[position%maxDigitsPerUint] != 0
+}
+
+// leadingZeroUints returns the amount of zero uints before the most significant non-zero uint of n.
+func (n *natural) leadingZeroUints() int {
+	for i := 0; i < numberOfUints; i++ {
+		if n[i] != 0 {
+			return i
+		}
+	}
+
+	return numberOfUints
+}
+
+// digits returns the amount of significant decimal digits of n. Zero has no significant digits.
+func (n natural) digits() int {
+	for i := 0; i < numberOfUints; i++ {
+		if n[i] == 0 {
+			continue
+		}
+
+		d := (numberOfUints - i - 1) * maxDigitsPerUint
+		for p := 0; p < maxDigitsPerUint && n[i] >= pow10[p]; p++ {
+			d++
+		}
+
+		return d
+	}
+
+	return 0
+}
+
+// truncate sets to zero the given amount of the least significant decimal digits.
+func (n natural) truncate(digits int) natural {
+	if digits <= 0 {
+		return n
+	}
+
+	if digits >= naturalMaxLen {
+		return natural{}
+	}
+
+	for i := 0; i < digits/maxDigitsPerUint; i++ {
+		n[numberOfUints-1-i] = 0
+	}
+
+	i := numberOfUints - 1 - digits/maxDigitsPerUint
+	n[i] -= n[i] % pow10[digits%maxDigitsPerUint]
+
+	return n
+}
+
+// compareHalf compares the given amount of least significant decimal digits with half unit of the next
+// digit, returning -1 if lesser, 0 if equal and 1 if greater. The sticky argument tells whether non-zero
+// digits after the least significant digit of n were already discarded.
+func (n natural) compareHalf(digits int, sticky bool) int {
+	return compareHalfFromDigit(n.digitAt(digits-1), sticky || n.hasDigitsBelow(digits-1))
+}
+
+// newNatFromUint64 returns x as a double precision number. Like mul, the first return is the result, and the
+// second return is its overflow, which is only non-zero with a single uint.
+func newNatFromUint64(x uint64) (natural, natural) {
+	var n, overflow natural
+
+	for i := numberOfUints - 1; i >= 0; i-- {
+		n[i], x = x%(maxValuePerUint+1), x/(maxValuePerUint+1)
+	}
+
+	overflow[numberOfUints-1] = x
+
+	return n, overflow
+}
+
+// addUint64 adds x to the uint at the given index of n, propagating the carry to the most significant uints.
+// The second return reports whether the result overflows.
+func (n natural) addUint64(index int, x uint64) (natural, bool) {
+	carry := x
+
+	// Since n[i] <= maxValuePerUint and x < 2^63, the sum can't overflow an uint64.
+	for i := index; i >= 0 && carry != 0; i-- {
+		n[i], carry = rebalance(n[i]+carry, 0)
+	}
+
+	return n, carry != 0
+}
+
+// subUint64 subtracts x from the uint at the given index of n, propagating the borrow to the most significant
+// uints. The second return is the borrow of the operation, i.e. non-zero if x.(maxValuePerUint+1)^index
+// is greater than n.
+func (n natural) subUint64(index int, x uint64) (natural, uint64) {
+	left, right := x/(maxValuePerUint+1), x%(maxValuePerUint+1)
+
+	var borrow uint64
+
+	n[index], borrow = subUint(n[index], right)
+
+	for i := index - 1; i >= 0 && left+borrow != 0; i-- {
+		n[i], borrow = subUint(n[i], left+borrow)
+		left = 0
+	}
+
+	if left != 0 {
+		borrow = 1
+	}
+
+	return n, borrow
+}
+
+// shiftLeft returns n.10^digits, for a non-negative digits argument.
+// The second return reports whether the result overflows.
+func (n natural) shiftLeft(digits int) (natural, bool) {
+	if n.isZero() {
+		return n, false
+	}
+
+	if digits >= naturalMaxLen {
+		return natural{}, true
+	}
+
+	padded, overflow := n.padLeft(digits / maxDigitsPerUint)
+	if !overflow.isZero() {
+		return natural{}, true
+	}
+
+	result, carry := padded.mulByUint64(pow10[digits%maxDigitsPerUint])
+
+	return result, carry != 0
+}
+
+// mulPow10 returns n.10^digits as a double precision number, for a digits argument between 0 and
+// naturalMaxLen. Like mul, the first return is the result, and the second return is its overflow.
+func (n natural) mulPow10(digits int) (natural, natural) {
+	result, overflow := n.padLeft(digits / maxDigitsPerUint)
+
+	if p := pow10[digits%maxDigitsPerUint]; p != 1 {
+		var carry uint64
+
+		result, carry = result.mulByUint64(p)
+
+		// Since n.10^digits < 10^(2*naturalMaxLen), the overflow multiplication can't overflow.
+		overflow, _ = overflow.mulByUint64(p)
+		// Since the carry is lesser than p, it fits in the last uint.
+		overflow, _ = overflow.addUint64(numberOfUints-1, carry)
+	}
+
+	return result, overflow
+}
+
+// shiftRight returns n/10^digits, for a non-negative digits argument, following the given rounding mode.
+// The neg argument tells whether the number represented by n is negative. The second return reports
+// whether the result is exact, i.e. no non-zero digit was discarded.
+func (n natural) shiftRight(digits int, mode RoundingMode, neg bool) (natural, bool) {
+	if digits <= 0 {
+		return n, true
+	}
+
+	var quo natural
+
+	if digits < naturalMaxLen {
+		quo, _ = n.padRight(digits / maxDigitsPerUint)
+		shortDivision(quo[:], quo[:], pow10[digits%maxDigitsPerUint])
+	}
+
+	if !n.hasDigitsBelow(digits) {
+		return quo, true
+	}
+
+	half := n.compareHalf(digits, false)
+	odd := quo[numberOfUints-1]%2 == 1
+
+	if mode.roundsUp(neg, odd, half, true) {
+		// Since at least one digit was discarded, the quotient can't overflow.
+		quo = quo.add(pow10Natural(0))
+	}
+
+	return quo, false
+}
+
+// pow10Natural returns 10^exp as a natural number. This operation panics on overflow.
+func pow10Natural(exp int) natural {
+	if exp < 0 || exp >= naturalMaxLen {
+		panic(fmt.Sprintf("natural number overflow: 10^%d", exp))
+	}
+
+	var n natural
+
+	n[numberOfUints-1-exp/maxDigitsPerUint] = pow10[exp%maxDigitsPerUint]
+
+	return n
+}
+
+// round rounds n to a multiple of 10^digits, following the given rounding mode.
+// The neg argument tells whether the number represented by n is negative, and
+// sticky whether non-zero digits after the least significant digit of n were
+// already discarded. The second return reports whether the rounding overflowed.
+func (n natural) round(digits int, mode RoundingMode, neg, sticky bool) (natural, bool) {
+	if digits <= 0 {
+		return n, false
+	}
+
+	half := n.compareHalf(digits, sticky)
+	odd := n.digitAt(digits)%2 == 1
+
+	truncated := n.truncate(digits)
+
+	if !mode.roundsUp(neg, odd, half, sticky || n.hasDigitsBelow(digits)) {
+		return truncated, false
+	}
+
+	if digits >= naturalMaxLen {
+		return natural{}, true
+	}
+
+	result, over := truncated.addOverflow(pow10Natural(digits))
+
+	return result, over > 0
+}
+
+const (
+	// maxRootDegree is the greatest degree supported by root.
+	maxRootDegree = 64
+	// rootUints is the amount of uints used to store the intermediate values of root.
+	// It's enough to store the maxRootDegree-th power of a natural number plus one uint.
+	rootUints = maxRootDegree*(numberOfUints+1) + 1
+)
+
+// root returns the degree-th root of n.10^scaleDigits, using the integer Newton's method. The root is
+// rounded to the nearest integer if nearest is true, or truncated otherwise, and in the latter case the
+// second return reports whether the root is exact. The degree should be between 1 and maxRootDegree,
+// and the number n.10^scaleDigits should fit in rootUints uints.
+func (n natural) root(degree, scaleDigits int, nearest bool) (natural, bool) {
+	if n.isZero() {
+		return n, true
+	}
+
+	// x stores n.10^scaleDigits.
+	var xArr [rootUints]uint64
+
+	x := xArr[len(xArr)-numberOfUints-scaleDigits/maxDigitsPerUint-1:]
+	copy(x[1:], n[:])
+	mulUintsByUint(x, pow10[scaleDigits%maxDigitsPerUint])
+	x = trimUints(x)
+
+	if degree == 1 {
+		var result natural
+		copy(result[numberOfUints-len(x):], x)
+
+		return result, true
+	}
+
+	var powArr, tmpArr, uArr, qArr [rootUints]uint64
+
+	r := n.rootEstimation(degree, scaleDigits)
+
+	// The Newton's method for x^(1/degree) is:
+	// r' = ((degree-1).r + x/r^(degree-1)) / degree
+	// Starting from an estimation greater than the root, the sequence decreases until it reaches the
+	// integer root.
+	for {
+		pow := powUints(powArr[:], tmpArr[:], trimUints(r[:]), degree-1)
+
+		u := uArr[len(uArr)-len(x)-1:]
+		u[0] = 0
+		copy(u[1:], x)
+
+		var quo natural
+
+		if len(pow) > len(x) {
+			quo = natural{}
+		} else {
+			q := qArr[len(qArr)-len(u):]
+			longDivision(q, u, pow)
+
+			q = trimUints(q)
+			copy(quo[numberOfUints-len(q):], q)
+		}
+
+		// next = ((degree-1).r + quo) / degree
+		var next [numberOfUints + 1]uint64
+
+		copy(next[1:], r[:])
+		mulUintsByUint(next[:], uint64(degree-1))
+		addUints(next[:], quo[:])
+		shortDivision(next[:], next[:], uint64(degree))
+
+		var nextNat natural
+		copy(nextNat[:], next[1:])
+
+		if nextNat.greaterThanOrEqual(r) {
+			break
+		}
+
+		r = nextNat
+	}
+
+	if !nearest {
+		pow := powUints(powArr[:], tmpArr[:], trimUints(r[:]), degree)
+
+		return r, compareUints(pow, x) == 0
+	}
+
+	// Since (r + 1/2)^degree can't be an integer, the root is rounded up if (r + 1/2)^degree < x,
+	// i.e. (2.r + 1)^degree < 2^degree.x
+	var halfUp [numberOfUints + 1]uint64
+
+	copy(halfUp[1:], r[:])
+	mulUintsByUint(halfUp[:], 2)
+	addUints(halfUp[:], []uint64{1})
+
+	pow := powUints(powArr[:], tmpArr[:], trimUints(halfUp[:]), degree)
+
+	scaled := tmpArr[len(tmpArr)-len(x)-(degree+maxDigitsPerUint)/maxDigitsPerUint-1:]
+	clear(scaled)
+	copy(scaled[len(scaled)-len(x):], x)
+
+	for exp := degree; exp > 0; exp -= 32 {
+		mulUintsByUint(scaled, 1<<min(exp, 32))
+	}
+
+	if compareUints(pow, scaled) < 0 {
+		r = r.add(pow10Natural(0))
+	}
+
+	return r, false
+}
+
+// rootEstimation estimates the degree-th root of n.10^scaleDigits using float numbers.
+// The estimation is guaranteed to be greater than the root.
+func (n natural) rootEstimation(degree, scaleDigits int) natural {
+	i := 0
+	for n[i] == 0 {
+		i++
+	}
+
+	mantissa := float64(n[i])
+	if i+1 < numberOfUints {
+		mantissa += float64(n[i+1]) / (maxValuePerUint + 1)
+	}
+
+	exp := (math.Log10(mantissa) + float64((numberOfUints-1-i)*maxDigitsPerUint+scaleDigits)) / float64(degree)
 
-		if n[i] > v[i] {
+	// The estimation is increased by a relative error that is way greater than the float errors.
+	const rootEstimationError = 1 + 1e-9
+
+	if exp < maxDigitsPerUint-2 {
+		var r natural
+		r[numberOfUints-1] = uint64(math.Pow(base, exp)*rootEstimationError) + 1
+
+		return r
+	}
+
+	// Keeping 17 digits in the uint mantissa.
+	shift := int(exp) - (maxDigitsPerUint - 2)
+
+	r, _ := pow10Natural(shift).mulByUint64(uint64(math.Pow(base, exp-float64(shift))*rootEstimationError) + 1)
+
+	return r
+}
+
+// trimUints returns the uints without the leading zeros, keeping at least one uint.
+func trimUints(x []uint64) []uint64 {
+	for len(x) > 1 && x[0] == 0 {
+		x = x[1:]
+	}
+
+	return x
+}
+
+// isZeroUints reports whether all the uints of x are zero.
+func isZeroUints(x []uint64) bool {
+	for _, v := range x {
+		if v != 0 {
 			return false
 		}
 	}
 
-	// are equal
-	return onEqual
+	return true
+}
+
+// compareUints compares the numbers represented by the uints a and b, returning
+// -1 if a is lesser than b, 0 if they're equal, and 1 if a is greater than b.
+func compareUints(a, b []uint64) int {
+	a, b = trimUints(a), trimUints(b)
+
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+
+		return 1
+	}
+
+	for i := range a {
+		if a[i] < b[i] {
+			return -1
+		}
+
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// addUints adds in place the number represented by v to x.
+// The return is the overflow of the operation.
+func addUints(x, v []uint64) uint64 {
+	var carry uint64
+
+	for i := 1; i <= len(x); i++ {
+		sum := x[len(x)-i] + carry
+		if i <= len(v) {
+			sum += v[len(v)-i]
+		}
+
+		x[len(x)-i], carry = rebalance(sum, 0)
+	}
+
+	return carry
+}
+
+// mulUints stores a*b into dst, which should have len(a)+len(b) uints.
+func mulUints(dst, a, b []uint64) {
+	clear(dst)
+
+	for i := len(b) - 1; i >= 0; i-- {
+		var carry uint64
+
+		for j := len(a) - 1; j >= 0; j-- {
+			dst[i+j+1], carry = mulAddUint(a[j], b[i], dst[i+j+1]+carry)
+		}
+
+		dst[i] = carry
+	}
+}
+
+// powUints calculates base^exp, using dst and tmp as buffers, which should have
+// at least exp*len(base) uints. The return is the result, stored at the end of dst.
+func powUints(dst, tmp, base []uint64, exp int) []uint64 {
+	result := dst[len(dst)-1:]
+	result[0] = 1
+
+	for i := 0; i < exp; i++ {
+		product := tmp[len(tmp)-len(result)-len(base):]
+		mulUints(product, result, base)
+
+		product = trimUints(product)
+
+		result = dst[len(dst)-len(product):]
+		copy(result, product)
+	}
+
+	return result
 }
@@ -0,0 +1,84 @@
+package moedinha
+
+import "fmt"
+
+// RoundingMode defines how the discarded digits of an operation are handled.
+type RoundingMode int
+
+const (
+	// Down rounds towards zero, i.e. truncates the discarded digits.
+	Down RoundingMode = iota
+	// Up rounds away from zero.
+	Up
+	// Ceiling rounds towards positive infinity.
+	Ceiling
+	// Floor rounds towards negative infinity.
+	Floor
+	// HalfUp rounds towards the nearest neighbour, or away from zero if both neighbours are equidistant.
+	HalfUp
+	// HalfDown rounds towards the nearest neighbour, or towards zero if both neighbours are equidistant.
+	HalfDown
+	// HalfEven rounds towards the nearest neighbour, or towards the even neighbour if both neighbours
+	// are equidistant. Also known as banker's rounding.
+	HalfEven
+)
+
+// DefaultRoundingMode is the rounding mode used by operations that discard digits
+// without receiving an explicit rounding mode, like Mul, Div and MulDiv.
+// It should be set once, during the program initialization.
+var DefaultRoundingMode = Down
+
+// roundsUp reports whether a truncated absolute value should be incremented by one unit.
+// The neg argument tells whether the value is negative, odd whether the last kept digit is odd,
+// half is the comparison of the discarded digits with half unit (-1 if lesser, 0 if equal and 1
+// if greater), and nonZero whether any discarded digit is non-zero.
+func (m RoundingMode) roundsUp(neg, odd bool, half int, nonZero bool) bool {
+	switch m {
+	case Down:
+		return false
+	case Up:
+		return nonZero
+	case Ceiling:
+		return nonZero && !neg
+	case Floor:
+		return nonZero && neg
+	case HalfUp:
+		return half >= 0
+	case HalfDown:
+		return half > 0
+	case HalfEven:
+		return half > 0 || (half == 0 && odd)
+	default:
+		panic(fmt.Sprintf("invalid rounding mode: %d", m))
+	}
+}
+
+// compareHalfFromDigit compares the discarded digits with half unit, given the
+// first discarded digit, and whether there is any non-zero digit after it.
+func compareHalfFromDigit(digit uint64, sticky bool) int {
+	switch {
+	case digit > base/2:
+		return 1
+	case digit < base/2:
+		return -1
+	case sticky:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareHalfFromRemainder compares the discarded fraction r/v with half unit,
+// where r is the remainder of a division by v.
+func compareHalfFromRemainder(r, v natural) int {
+	complement := v.sub(r)
+
+	switch {
+	case r.greaterThan(complement):
+		return 1
+	case r.lessThan(complement):
+		return -1
+	default:
+		return 0
+	}
+}
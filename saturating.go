@@ -0,0 +1,100 @@
+package moedinha
+
+import "fmt"
+
+// maxNatural is the greatest natural number, with all digits set to 9.
+var maxNatural = natural{}.complement()
+
+// MaxValue returns the greatest Currency that can be represented with the settings.go configuration,
+// e.g. 999999999999999999999999999999999999999999999999999999.999999999999999999 for the default one.
+func MaxValue() Currency {
+	return Currency{t: newInteger(maxNatural, false)}
+}
+
+// MinValue returns the lowest Currency that can be represented with the settings.go configuration,
+// i.e. the negative of MaxValue.
+func MinValue() Currency {
+	return Currency{t: newInteger(maxNatural, true)}
+}
+
+// maxFixed returns the greatest natural number that fits in the digits of P.
+func maxFixed[P Precision]() natural {
+	decimals, integers := precisionOf[P]()
+
+	if integers == currencyMaxIntegerDigits {
+		return maxNatural.truncate(currencyDecimalDigits - decimals)
+	}
+
+	return pow10Natural(currencyDecimalDigits + integers).sub(pow10Natural(currencyDecimalDigits - decimals))
+}
+
+// MaxFixed returns the greatest Fixed number with precision P, e.g. 9999999999999999.99 for 16 integer digits
+// and 2 decimal digits.
+func MaxFixed[P Precision]() Fixed[P] {
+	checkPrecision[P]()
+
+	return Fixed[P]{t: newInteger(maxFixed[P](), false)}
+}
+
+// MinFixed returns the lowest Fixed number with precision P, i.e. the negative of MaxFixed.
+func MinFixed[P Precision]() Fixed[P] {
+	checkPrecision[P]()
+
+	return Fixed[P]{t: newInteger(maxFixed[P](), true)}
+}
+
+// saturated returns MinFixed if neg is true, or MaxFixed otherwise.
+func saturated[P Precision](neg bool) Fixed[P] {
+	return Fixed[P]{t: newInteger(maxFixed[P](), neg)}
+}
+
+// SaturatingAdd returns c + v, clamping the result to MaxFixed or MinFixed on overflow instead of panicking.
+// The second return reports whether the result was clamped.
+func (c Fixed[P]) SaturatingAdd(v Fixed[P]) (Fixed[P], bool) {
+	result, overflow := c.add(v)
+	if overflow {
+		// The overflowing result always has the sign of c.
+		return saturated[P](c.t.isNeg()), true
+	}
+
+	return result, false
+}
+
+// SaturatingSub returns c - v, clamping the result to MaxFixed or MinFixed on overflow instead of panicking.
+// The second return reports whether the result was clamped.
+func (c Fixed[P]) SaturatingSub(v Fixed[P]) (Fixed[P], bool) {
+	result, overflow := c.sub(v)
+	if overflow {
+		// The overflowing result always has the sign of c.
+		return saturated[P](c.t.isNeg()), true
+	}
+
+	return result, false
+}
+
+// SaturatingMul returns c * v, like Mul, but clamping the result to MaxFixed or MinFixed on overflow instead
+// of panicking. The second return reports whether the result was clamped.
+func (c Fixed[P]) SaturatingMul(v Fixed[P]) (Fixed[P], bool) {
+	result, _, overflow := c.mulRound(v, DefaultRoundingMode)
+	if overflow {
+		return saturated[P](c.t.isNeg() != v.t.isNeg()), true
+	}
+
+	return result, false
+}
+
+// SaturatingDiv returns c / v, like Div, but clamping the result to MaxFixed or MinFixed on overflow instead
+// of panicking. The second return reports whether the result was clamped.
+// This operation still panics on division by zero, like Div.
+func (c Fixed[P]) SaturatingDiv(v Fixed[P]) (Fixed[P], bool) {
+	if v.t.isZero() {
+		panic(fmt.Sprintf("division by zero: %s / %s", c.String(), v.String()))
+	}
+
+	result, _, overflow := c.divRound(v, currencyDecimalDigits, DefaultRoundingMode)
+	if overflow {
+		return saturated[P](c.t.isNeg() != v.t.isNeg()), true
+	}
+
+	return result, false
+}
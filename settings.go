@@ -2,9 +2,10 @@ package moedinha
 
 const (
 	// numberOfUints stores the amount of uint64 used to represent the currency.
-	// Each uint is able to store 18 significant digits.
+	// Each uint is able to store 18 significant digits, and up to 17 uints are supported.
 	numberOfUints = 4
-	// uintsReservedToDecimal the number of uints from  numberOfUints that is
-	// reserved to the decimal places.
-	uintsReservedToDecimal = 1
+	// digitsReservedToDecimal the number of digits from the numberOfUints uints that is
+	// reserved to the decimal places. It doesn't need to be a multiple of 18, but at least
+	// one digit should be left to the integer part, so the number 1 can be represented.
+	digitsReservedToDecimal = 18
 )
@@ -1,6 +1,6 @@
 package moedinha
 
-import "fmt"
+import "math/bits"
 
 const (
 	// base is the base value used by the library.
@@ -9,10 +9,31 @@ const (
 	maxValuePerUint = 999999999999999999
 	// maxDigitsPerUint is the amount of maxValuePerUint digits.
 	maxDigitsPerUint = 18
-	// halfMaxValuePerUint is half of maxValuePerUint digits.
-	halfMaxValuePerUint = 999999999
 )
 
+// pow10 stores the powers of ten that fits in a single uint.
+var pow10 = [maxDigitsPerUint + 1]uint64{
+	1,
+	10,
+	100,
+	1000,
+	10000,
+	100000,
+	1000000,
+	10000000,
+	100000000,
+	1000000000,
+	10000000000,
+	100000000000,
+	1000000000000,
+	10000000000000,
+	100000000000000,
+	1000000000000000,
+	10000000000000000,
+	100000000000000000,
+	1000000000000000000,
+}
+
 // rebalance truncates the src to maxValuePerUint, returns it at newSrc and adds the reminder to newDest.
 func rebalance(src, dest uint64) (newSrc, newDest uint64) {
 	dest += src / (maxValuePerUint + 1)
@@ -21,55 +42,68 @@ func rebalance(src, dest uint64) (newSrc, newDest uint64) {
 	return src, dest
 }
 
-// splitInHalf splits the given uint64 into two parts of 9 digits.
-// The first return is the left part, and last is the right part.
-func splitInHalf(a uint64) (uint64, uint64) {
-	return a / (halfMaxValuePerUint + 1), a % (halfMaxValuePerUint + 1)
-}
+// mulAddUint calculates a*b + c, returning the result split by the maxValuePerUint boundary.
+// The first return is the right part, and the last is the left part.
+// The a argument should be lesser or equal than maxValuePerUint, so the left part always fits in an uint64,
+// even for the greatest b and c arguments.
+func mulAddUint(a, b, c uint64) (uint64, uint64) {
+	hi, lo := bits.Mul64(a, b)
 
-// multiplyUint multiply two uint64 and return the result and
-// the overflow of the multiplication.
-func multiplyUint(a, b uint64) (uint64, uint64) {
-	// All those components are less than 32-bits values.
-	aLeft, aRight := splitInHalf(a) // aLeft is the right bits of a, and al the right bits.
-	bLeft, bRight := splitInHalf(b) // The same.
-
-	// Each operation will overflow the 32-bits components and go to 64-bits components.
-	// So right, middle, and left will potentially overflow the 32-bits boundary.
-	// The idea here is that:
-	// a = (aLeft.10^9 + aRight)
-	// b = (bLeft.10^9 + bRight)
-	// So a.b = aLeft.bLeft.10^(2*9) + (aLeft.bRight + aRight.bLeft).10^(9) + (aRight*bRight)
-	right := aRight * bRight
-	middle := aLeft*bRight + aRight*bLeft
-	left := bLeft * aLeft
-
-	// Now we should distribute the middle term to the respective side:
-	middleLeft, middleRight := splitInHalf(middle)
-
-	// middleLeft is added to left as the first digits.
-	left += middleLeft
-	// middleRight is added to right as the last digits.
-	right += middleRight * (halfMaxValuePerUint + 1)
-
-	// Rebalance right to left to avoid overflows.
-	right, left = rebalance(right, left)
+	var carry uint64
+	lo, carry = bits.Add64(lo, c, 0)
+	hi += carry
+
+	left, right := bits.Div64(hi, lo, maxValuePerUint+1)
 
 	return right, left
 }
 
-// atoi is a fork from strconv.Atoi with proper signature.
-func atoi(s [maxDigitsPerUint]byte) (uint64, error) {
-	var n uint64
-	for _, ch := range s {
-		ch -= zeroRune
-		if ch > base-1 {
-			return 0, fmt.Errorf("invalid syntax converting string to uint64: rune %c", ch)
-		}
-		n = n*base + uint64(ch)
+// divUint divides the two uints number "left.(maxValuePerUint+1) + right" by d.
+// The first return is the quotient, and the last is the remainder.
+// The left argument should be lesser than d, to ensure that the quotient fits in an uint64.
+func divUint(left, right, d uint64) (uint64, uint64) {
+	hi, lo := bits.Mul64(left, maxValuePerUint+1)
+
+	var carry uint64
+	lo, carry = bits.Add64(lo, right, 0)
+	hi += carry
+
+	return bits.Div64(hi, lo, d)
+}
+
+// addUint calculates a + b, where a and b are lesser or equal to maxValuePerUint+1.
+// The first return is the result, and the last is the carry of the operation.
+func addUint(a, b uint64) (uint64, uint64) {
+	if sum := a + b; sum > maxValuePerUint {
+		return sum - (maxValuePerUint + 1), 1
 	}
 
-	return n, nil
+	return a + b, 0
+}
+
+// subUint calculates a - b, where a is lesser or equal to maxValuePerUint
+// and b is lesser or equal to maxValuePerUint+1.
+// The first return is the result, and the last is the borrow of the operation.
+func subUint(a, b uint64) (uint64, uint64) {
+	if a < b {
+		return a + (maxValuePerUint + 1) - b, 1
+	}
+
+	return a - b, 0
+}
+
+// mulGreaterThan reports whether a*b is greater than the two uints
+// number "left.(maxValuePerUint+1) + right".
+func mulGreaterThan(a, b, left, right uint64) bool {
+	mHi, mLo := bits.Mul64(a, b)
+
+	hi, lo := bits.Mul64(left, maxValuePerUint+1)
+
+	var carry uint64
+	lo, carry = bits.Add64(lo, right, 0)
+	hi += carry
+
+	return mHi > hi || (mHi == hi && mLo > lo)
 }
 
 // itoa is a fork from strconv.Itoa with proper signature.
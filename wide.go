@@ -0,0 +1,332 @@
+package moedinha
+
+import "math"
+
+const (
+	// wideDecimalUints is the amount of uints reserved to the decimal digits of a wide number.
+	wideDecimalUints = wideUints - 1
+	// wideDecimalDigits is the amount of decimal digits of a wide number.
+	wideDecimalDigits = wideDecimalUints * maxDigitsPerUint
+	// expHalvings is how many times the argument of expWide is halved before the series evaluation.
+	// Each halving makes the series converge faster, and costs one squaring at the end.
+	expHalvings = 8
+	// lnMaxIterations is the maximum amount of iterations of the lnWide method.
+	lnMaxIterations = 10
+)
+
+// wide is an unsigned fixed-point number with the size of two natural numbers, where the first uint is the
+// integer part and the remaining wideDecimalUints uints are the decimal part. It's used as an intermediate
+// number with extra precision by the transcendental functions, like Exp and Ln.
+type wide [wideUints]uint64
+
+var (
+	wideOne = wide{1}
+	// wideLn10 stores the natural logarithm of 10.
+	wideLn10 = lnWide(wide{base})
+)
+
+// newWideFromFloat returns the wide number closest to the non-negative float f.
+func newWideFromFloat(f float64) wide {
+	integerPart, decimalPart := math.Modf(math.Max(f, 0))
+
+	return wide{uint64(integerPart), min(uint64(decimalPart*(maxValuePerUint+1)), maxValuePerUint)}
+}
+
+// newWideFromNatural returns the wide number represented by n divided by 10^digits, where digits should be
+// lesser or equal than wideDecimalDigits. The second return reports whether the integer part of the result
+// overflows the first uint of the wide number.
+func newWideFromNatural(n natural, digits int) (wide, bool) {
+	shift := wideDecimalDigits - digits
+
+	// The natural number is placed at the end of the buffer, shifted left by the whole uints of the
+	// shift, and then multiplied by the remaining power of 10.
+	var buf [numberOfUints + wideUints]uint64
+
+	limbs := min(shift/maxDigitsPerUint, wideUints)
+
+	copy(buf[wideUints-limbs:], n[:])
+
+	carry := mulUintsByUint(buf[:], pow10[shift%maxDigitsPerUint])
+
+	var w wide
+
+	copy(w[:], buf[numberOfUints:])
+
+	return w, carry != 0 || !isZeroUints(buf[:numberOfUints])
+}
+
+// newNormalizedWide returns the floating representation of the non-zero n divided by 10^digits, i.e. a wide
+// number w between 1 and 10, and the exponent e, such that n/10^digits = w.10^e.
+func newNormalizedWide(n natural, digits int) (wide, int) {
+	significant := n.digits()
+
+	// Since the natural number has at most naturalMaxLen digits, the conversion can't overflow.
+	w, _ := newWideFromNatural(n, significant-1)
+
+	return w, significant - 1 - digits
+}
+
+// mulNormalized multiplies two wide numbers between 1 and 10, returning the product normalized between 1 and
+// 10, and the exponent of the power of 10 removed from it, i.e. 0 or 1.
+func (w wide) mulNormalized(v wide) (wide, int) {
+	product := w.mul(v)
+	if product[0] < base {
+		return product, 0
+	}
+
+	return product.divUint(base), 1
+}
+
+// reciprocalNormalized returns the reciprocal of a wide number between 1 and 10, normalized between 1 and 10,
+// and the exponent of the power of 10 applied to it, i.e. 0 or -1.
+func (w wide) reciprocalNormalized() (wide, int) {
+	reciprocal := wideOne.div(w)
+	if reciprocal[0] != 0 {
+		return reciprocal, 0
+	}
+
+	return reciprocal.mulUint(base), -1
+}
+
+// float64 returns the float closest to w.
+func (w wide) float64() float64 {
+	return float64(w[0]) + float64(w[1])/(maxValuePerUint+1)
+}
+
+func (w wide) isZero() bool {
+	return isZeroUints(w[:])
+}
+
+// isNegligible reports whether w is lesser than the last uint unit, i.e. 10^-(wideDecimalDigits-maxDigitsPerUint).
+func (w wide) isNegligible() bool {
+	return isZeroUints(w[:wideUints-1])
+}
+
+// add sums two wide numbers. The second return reports whether the sum overflows the wide number.
+func (w wide) add(v wide) (wide, bool) {
+	carry := addUints(w[:], v[:])
+
+	return w, carry != 0
+}
+
+// sub calculates the absolute value of the subtraction w - v.
+// The second return reports whether the subtraction is negative.
+func (w wide) sub(v wide) (wide, bool) {
+	if compareUints(w[:], v[:]) < 0 {
+		return subUints(v, w), true
+	}
+
+	return subUints(w, v), false
+}
+
+// subUints calculates a - b, where a should be greater or equal than b.
+func subUints(a, b wide) wide {
+	var borrow uint64
+
+	for i := wideUints - 1; i >= 0; i-- {
+		a[i], borrow = subUint(a[i], b[i]+borrow)
+	}
+
+	return a
+}
+
+// mul multiplies two wide numbers, rounding the discarded decimal digits half up.
+func (w wide) mul(v wide) wide {
+	var product [2 * wideUints]uint64
+
+	mulUints(product[:], w[:], v[:])
+
+	var result wide
+
+	copy(result[:], product[wideUints-wideDecimalUints:2*wideUints-wideDecimalUints])
+
+	if product[2*wideUints-wideDecimalUints] > maxValuePerUint/2 {
+		addUints(result[:], wideOne[len(wideOne)-1:])
+	}
+
+	return result
+}
+
+// mulUint multiplies a wide number by an uint lesser or equal than maxValuePerUint.
+// The operation overflow is discarded.
+func (w wide) mulUint(x uint64) wide {
+	mulUintsByUint(w[:], x)
+
+	return w
+}
+
+// divUint divides a wide number by a non-zero uint, truncating the result.
+func (w wide) divUint(x uint64) wide {
+	shortDivision(w[:], w[:], x)
+
+	return w
+}
+
+// div divides two wide numbers, truncating the result. The divisor should be non-zero.
+func (w wide) div(v wide) wide {
+	// The dividend is shifted by wideDecimalUints to keep the decimal digits in the quotient,
+	// with an extra leading uint required by the long division.
+	var u, q [wideUints + wideDecimalUints + 1]uint64
+
+	copy(u[1:], w[:])
+
+	divisor := trimUints(v[:])
+
+	if len(divisor) == 1 {
+		shortDivision(q[:], u[:], divisor[0])
+	} else {
+		longDivision(q[:], u[:], divisor)
+	}
+
+	var result wide
+
+	copy(result[:], q[len(q)-wideUints:])
+
+	return result
+}
+
+// hasDigitsBelow reports whether there is any non-zero decimal digit before the given position, where
+// position 0 is the least significant digit of the w uints.
+func (w wide) hasDigitsBelow(position int) bool {
+	limbs := position / maxDigitsPerUint
+
+	if !isZeroUints(w[wideUints-limbs:]) {
+		return true
+	}
+
+	return w[wideUints-1-limbs]%pow10[position%maxDigitsPerUint] != 0
+}
+
+// toNatural returns the natural number closest to the w representation divided by 10^digits,
+// following the given rounding mode. The neg argument tells whether w represents a negative
+// number. The second return reports whether the result overflows the natural number.
+// Unlike the other wide methods, w is passed by reference, avoiding its copy in the multiplications.
+func (w *wide) toNatural(digits int, mode RoundingMode, neg bool) (natural, bool) {
+	limbs, limbDigits := digits/maxDigitsPerUint, digits%maxDigitsPerUint
+
+	// Dropping the discarded uints, and dividing the remaining discarded digits.
+	var quo [wideUints]uint64
+
+	copy(quo[limbs:], w[:wideUints-limbs])
+
+	var rem uint64
+
+	if limbDigits > 0 {
+		rem = shortDivision(quo[:], quo[:], pow10[limbDigits])
+	}
+
+	// Finding the first discarded digit, and whether there is any non-zero digit after it.
+	discarded := w[wideUints-limbs:]
+
+	var first uint64
+
+	var sticky bool
+
+	switch {
+	case limbDigits > 0:
+		first, rem = rem/pow10[limbDigits-1], rem%pow10[limbDigits-1]
+		sticky = rem != 0 || !isZeroUints(discarded)
+	case limbs > 0:
+		first, rem = discarded[0]/pow10[maxDigitsPerUint-1], discarded[0]%pow10[maxDigitsPerUint-1]
+		sticky = rem != 0 || !isZeroUints(discarded[1:])
+	}
+
+	if !isZeroUints(quo[:wideUints-numberOfUints]) {
+		return natural{}, true
+	}
+
+	result := natural(quo[wideUints-numberOfUints:])
+
+	half := compareHalfFromDigit(first, sticky)
+	if !mode.roundsUp(neg, result[numberOfUints-1]%2 == 1, half, first != 0 || sticky) {
+		return result, false
+	}
+
+	result, over := result.addOverflow(pow10Natural(0))
+
+	return result, over > 0
+}
+
+// newFixedFromWide returns the Fixed number closest to w, which represents a number with the given decimal
+// digits, rounded to the decimal digits of P using HalfEven. The neg argument tells whether w represents a
+// negative number. The second return reports whether the result doesn't fit in the integer digits of P.
+func newFixedFromWide[P Precision](w wide, digits int, neg bool) (Fixed[P], bool) {
+	decimals, _ := precisionOf[P]()
+
+	n, overflow := w.toNatural(digits-decimals, HalfEven, neg)
+	if overflow {
+		return Fixed[P]{}, true
+	}
+
+	n, overflow = n.shiftLeft(currencyDecimalDigits - decimals)
+	if overflow || !fits[P](n) {
+		return Fixed[P]{}, true
+	}
+
+	return Fixed[P]{t: newInteger(n, neg)}, false
+}
+
+// expWide calculates e^w for a signed wide number, where neg tells whether w is negative.
+// The argument should be lesser than 10.
+func expWide(w wide, neg bool) wide {
+	s := w.divUint(1 << expHalvings)
+
+	// Taylor series: e^s = 1 + s + s^2/2! + s^3/3! + ...
+	sum, term := wideOne, wideOne
+	for i := uint64(1); !term.isZero(); i++ {
+		term = term.mul(s).divUint(i)
+		sum, _ = sum.add(term)
+	}
+
+	// e^w = (e^s)^(2^expHalvings)
+	for i := 0; i < expHalvings; i++ {
+		sum = sum.mul(sum)
+	}
+
+	if neg {
+		return wideOne.div(sum)
+	}
+
+	return sum
+}
+
+// lnWide calculates the natural logarithm of a wide number greater or equal than 1,
+// using the Halley's method over expWide.
+func lnWide(w wide) wide {
+	y, yNeg := newWideFromFloat(math.Log(w.float64())), false
+
+	for i := 0; i < lnMaxIterations; i++ {
+		// y' = y + 2.(w - e^y) / (w + e^y)
+		e := expWide(y, yNeg)
+
+		diff, diffNeg := w.sub(e)
+		// Since w and e^y are lesser than 10, the sum can't overflow.
+		sum, _ := w.add(e)
+		correction := diff.mulUint(2).div(sum)
+
+		y, yNeg, _ = addSigned(y, yNeg, correction, diffNeg)
+
+		if correction.isNegligible() {
+			break
+		}
+	}
+
+	return y
+}
+
+// addSigned sums two signed wide numbers, where aNeg and bNeg tells whether a and b are negative.
+// The second return tells whether the result is negative, and the last one whether the sum overflows.
+func addSigned(a wide, aNeg bool, b wide, bNeg bool) (wide, bool, bool) {
+	if aNeg == bNeg {
+		sum, overflow := a.add(b)
+
+		return sum, aNeg && !(a.isZero() && b.isZero()), overflow
+	}
+
+	diff, swapped := a.sub(b)
+	if swapped {
+		return diff, bNeg, false
+	}
+
+	return diff, aNeg && !diff.isZero(), false
+}